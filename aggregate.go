@@ -0,0 +1,50 @@
+package opt
+
+import "cmp"
+
+// Number is a constraint that permits any numeric type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~complex64 | ~complex128
+}
+
+// Min returns the option with the smaller wrapped value, see [cmp.Less].
+//
+// Empty options are treated as identity,
+// i.e. if only one option is present it is returned.
+func Min[V cmp.Ordered](a, b T[V]) T[V] {
+	if !a.present || (b.present && cmp.Less(b.v, a.v)) {
+		return b
+	}
+
+	return a
+}
+
+// Max returns the option with the larger wrapped value, see [cmp.Less].
+//
+// Empty options are treated as identity,
+// i.e. if only one option is present it is returned.
+func Max[V cmp.Ordered](a, b T[V]) T[V] {
+	if !a.present || (b.present && cmp.Less(a.v, b.v)) {
+		return b
+	}
+
+	return a
+}
+
+// Sum returns the sum of the wrapped values of all present options,
+// or an empty option if none is present.
+func Sum[V Number](opts ...T[V]) T[V] {
+	sum := None[V]()
+
+	for _, t := range opts {
+		value, present := t.Unwrap()
+		if present {
+			sum = Some(sum.OrZero() + value)
+		}
+	}
+
+	return sum
+}
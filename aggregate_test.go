@@ -0,0 +1,42 @@
+package opt_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleMin() {
+	fmt.Println(opt.Min(opt.Some(3), opt.Some(1)))
+	fmt.Println(opt.Min(opt.Some(3), opt.None[int]()))
+	fmt.Println(opt.Min(opt.None[int](), opt.None[int]()))
+	// Output: Some[int](1)
+	// Some[int](3)
+	// None[int]()
+}
+
+func ExampleMax() {
+	fmt.Println(opt.Max(opt.Some(3), opt.Some(1)))
+	fmt.Println(opt.Max(opt.None[int](), opt.Some(1)))
+	// Output: Some[int](3)
+	// Some[int](1)
+}
+
+func ExampleSum() {
+	fmt.Println(opt.Sum(opt.Some(1.5), opt.None[float64](), opt.Some(2.0)))
+	fmt.Println(opt.Sum[float64]())
+	// Output: Some[float64](3.5)
+	// None[float64]()
+}
+
+func TestMinMaxCommutative(t *testing.T) {
+	err := quick.Check(func(a, b opt.T[int]) bool {
+		return opt.Equal(opt.Min(a, b), opt.Min(b, a)) &&
+			opt.Equal(opt.Max(a, b), opt.Max(b, a))
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
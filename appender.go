@@ -0,0 +1,90 @@
+//go:build go1.24
+
+package opt
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+)
+
+// Text and Binary Appending.
+var (
+	_ encoding.TextAppender   = T[any]{}
+	_ encoding.BinaryAppender = T[any]{}
+)
+
+// AppendText implements [encoding.TextAppender].
+//
+// Like [T.MarshalText], but appends to b, delegating to the value,
+// if it implements [encoding.TextAppender], even if implemented by *V.
+// Builtin types are appended without allocating.
+func (t T[V]) AppendText(b []byte) ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return b, nil
+	}
+
+	if reflect.PointerTo(reflect.TypeFor[V]()).Implements(reflect.TypeFor[encoding.TextAppender]()) {
+		return appendTextPointer(b, value)
+	}
+
+	switch value := any(value).(type) {
+	case string:
+		return append(b, value...), nil
+	case bool:
+		return strconv.AppendBool(b, value), nil
+	case int:
+		return strconv.AppendInt(b, int64(value), 10), nil
+	case int64:
+		return strconv.AppendInt(b, value, 10), nil
+	case uint:
+		return strconv.AppendUint(b, uint64(value), 10), nil
+	case uint64:
+		return strconv.AppendUint(b, value, 10), nil
+	case float64:
+		return strconv.AppendFloat(b, value, 'g', -1, 64), nil
+	}
+
+	return appendText(b, value)
+}
+
+// appendTextPointer appends the value using the appender of *V,
+// copying the value, such that only this path allocates.
+func appendTextPointer[V any](b []byte, value V) ([]byte, error) {
+	return any(&value).(encoding.TextAppender).AppendText(b)
+}
+
+func appendText[V any](b []byte, value V) ([]byte, error) {
+	return appendTextValue(b, reflect.ValueOf(&value).Elem())
+}
+
+// AppendBinary implements [encoding.BinaryAppender].
+//
+// Like [T.MarshalBinary], but appends to b, delegating to the value,
+// if it implements [encoding.BinaryAppender] or [encoding.BinaryMarshaler],
+// even if implemented by *V.
+func (t T[V]) AppendBinary(b []byte) ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return append(b, binaryNone), nil
+	}
+
+	b = append(b, binarySome)
+
+	if reflect.PointerTo(reflect.TypeFor[V]()).Implements(reflect.TypeFor[encoding.BinaryAppender]()) {
+		return appendBinaryPointer(b, value)
+	}
+
+	payload, err := marshalBinary(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(b, payload...), nil
+}
+
+// appendBinaryPointer appends the value using the appender of *V, like [appendTextPointer].
+func appendBinaryPointer[V any](b []byte, value V) ([]byte, error) {
+	return any(&value).(encoding.BinaryAppender).AppendBinary(b)
+}
@@ -0,0 +1,157 @@
+//go:build go1.24
+
+package opt_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_AppendText() {
+	line := []byte("addr=")
+	line, _ = opt.Some(netip.MustParseAddr("::1")).AppendText(line)
+	line = append(line, " port="...)
+	line, _ = opt.Some(8080).AppendText(line)
+	line = append(line, " zone="...)
+	line, _ = opt.None[string]().AppendText(line)
+
+	fmt.Println(string(line))
+	// Output: addr=::1 port=8080 zone=
+}
+
+func ExampleT_AppendBinary() {
+	data, _ := opt.Some(netip.MustParseAddr("127.0.0.1")).AppendBinary([]byte{0xff})
+	data, _ = opt.None[netip.Addr]().AppendBinary(data)
+
+	fmt.Println(data)
+	// Output: [255 1 127 0 0 1 0]
+}
+
+func TestAppendTextLikeMarshalText(t *testing.T) {
+	err := quick.Check(func(i opt.T[int], f opt.T[float64], s opt.T[string], prefix []byte) bool {
+		return appendTextEqual(t, i, prefix) && appendTextEqual(t, f, prefix) && appendTextEqual(t, s, prefix)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func appendTextEqual[V any](t *testing.T, value opt.T[V], prefix []byte) bool {
+	want, err := value.MarshalText()
+	if err != nil {
+		t.Log(err.Error())
+		return false
+	}
+
+	got, err := value.AppendText(bytes.Clone(prefix))
+	if err != nil {
+		t.Log(err.Error())
+		return false
+	}
+
+	if !bytes.Equal(got, append(bytes.Clone(prefix), want...)) {
+		t.Logf("%s appended %q, want %q", value, got, want)
+		return false
+	}
+
+	return true
+}
+
+func TestAppendBinaryLikeMarshalBinary(t *testing.T) {
+	for _, value := range []opt.T[time.Time]{
+		opt.None[time.Time](),
+		opt.Some(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	} {
+		want, err := value.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := value.AppendBinary(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !bytes.Equal(got, want) {
+			t.Errorf("%s appended %v, want %v", value, got, want)
+		}
+
+		var decoded opt.T[time.Time]
+
+		err = decoded.UnmarshalBinary(got)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.IsPresent() != value.IsPresent() || !decoded.OrZero().Equal(value.OrZero()) {
+			t.Errorf("decoded %s, want %s", decoded, value)
+		}
+	}
+}
+
+func TestAppendBinaryPointerMarshaler(t *testing.T) {
+	got, err := opt.Some(Version{major: 1, minor: 2}).AppendBinary([]byte{0xff})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []byte{0xff, 1, 'v', 1, 2}
+	if !bytes.Equal(got, want) {
+		t.Errorf("appended %v, want %v", got, want)
+	}
+}
+
+func TestAppendTextAllocations(t *testing.T) {
+	buffer := make([]byte, 0, 64)
+
+	addr := opt.Some(netip.MustParseAddr("::1"))
+
+	allocs := testing.AllocsPerRun(100, func() {
+		_, _ = addr.AppendText(buffer[:0])
+	})
+	if allocs > 1 {
+		t.Errorf("expected at most one allocation appending %s, got %v", addr, allocs)
+	}
+
+	port := opt.Some(8080)
+
+	allocs = testing.AllocsPerRun(100, func() {
+		_, _ = port.AppendText(buffer[:0])
+	})
+	if allocs != 0 {
+		t.Errorf("expected no allocation appending %s, got %v", port, allocs)
+	}
+}
+
+// Hex implements [encoding.TextAppender] and [encoding.TextMarshaler] on its pointer.
+type Hex uint32
+
+var hexAppends int
+
+func (h *Hex) AppendText(b []byte) ([]byte, error) {
+	hexAppends++
+
+	return fmt.Appendf(b, "%#x", uint32(*h)), nil
+}
+
+func (h *Hex) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%#x", uint32(*h))), nil
+}
+
+func TestAppendTextPointerAppender(t *testing.T) {
+	hexAppends = 0
+
+	if !appendTextEqual(t, opt.Some(Hex(42)), []byte("id=")) {
+		t.Fatal("expected appended text like MarshalText")
+	}
+
+	if hexAppends != 1 {
+		t.Fatalf("expected the appender implemented by *V to be used, got %d calls", hexAppends)
+	}
+}
@@ -0,0 +1,273 @@
+package opt
+
+import (
+	"encoding/asn1"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// asn1Option is implemented by pointers to options, used to detect them in [MarshalASN1].
+type asn1Option interface {
+	marshalASN1(params string) (asn1.RawValue, error)
+	unmarshalASN1(raw asn1.RawValue, params string) error
+}
+
+var (
+	asn1OptionType   = reflect.TypeOf((*asn1Option)(nil)).Elem()
+	asn1RawValueType = reflect.TypeOf(asn1.RawValue{})
+
+	// asn1Mirrors caches the mirror types, see [asn1Mirror].
+	asn1Mirrors sync.Map
+)
+
+// MarshalASN1 is like [asn1.Marshal], but supports options as struct fields,
+// omitting empty options as absent OPTIONAL members,
+// while present options are encoded as their value, honoring the asn1 struct tag.
+//
+// Options should be tagged, e.g. `asn1:"explicit,tag:0"`,
+// as untagged options match any element when unmarshaling,
+// thus are only suitable for trailing members, like the parameters of an AlgorithmIdentifier.
+func MarshalASN1(v any) ([]byte, error) {
+	return marshalASN1WithParams(v, "")
+}
+
+// UnmarshalASN1 is like [asn1.Unmarshal], but supports options as struct fields,
+// see [MarshalASN1]. Absent OPTIONAL members are decoded as empty options.
+func UnmarshalASN1(data []byte, v any) ([]byte, error) {
+	return unmarshalASN1WithParams(data, v, "")
+}
+
+func marshalASN1WithParams(v any, params string) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() {
+		return asn1.MarshalWithParams(v, params)
+	}
+
+	mirrorType, changed := asn1Mirror(rv.Type())
+	if !changed {
+		return asn1.MarshalWithParams(v, params)
+	}
+
+	mirror := reflect.New(mirrorType).Elem()
+
+	err := toASN1Mirror(rv, mirror, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.MarshalWithParams(mirror.Interface(), params)
+}
+
+func unmarshalASN1WithParams(data []byte, v any, params string) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	if !rv.IsValid() || rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return asn1.UnmarshalWithParams(data, v, params)
+	}
+
+	mirrorType, changed := asn1Mirror(rv.Type().Elem())
+	if !changed {
+		return asn1.UnmarshalWithParams(data, v, params)
+	}
+
+	mirror := reflect.New(mirrorType)
+
+	rest, err := asn1.UnmarshalWithParams(data, mirror.Interface(), params)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fromASN1Mirror(mirror.Elem(), rv.Elem(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	return rest, nil
+}
+
+func (t T[V]) marshalASN1(params string) (asn1.RawValue, error) {
+	value, present := t.Unwrap()
+	if !present {
+		//nolint:exhaustruct
+		return asn1.RawValue{}, nil
+	}
+
+	data, err := marshalASN1WithParams(value, params)
+	if err != nil {
+		//nolint:exhaustruct
+		return asn1.RawValue{}, err
+	}
+
+	//nolint:exhaustruct
+	return asn1.RawValue{FullBytes: data}, nil
+}
+
+func (t *T[V]) unmarshalASN1(raw asn1.RawValue, params string) error {
+	if len(raw.FullBytes) == 0 {
+		*t = None[V]()
+
+		return nil
+	}
+
+	var value V
+
+	rest, err := unmarshalASN1WithParams(raw.FullBytes, &value, params)
+	if err != nil {
+		return err
+	}
+
+	if len(rest) != 0 {
+		return asn1.SyntaxError{Msg: "trailing data after optional member"}
+	}
+
+	*t = Some(value)
+
+	return nil
+}
+
+// asn1Mirror returns a type, that mirrors the given type with options replaced by
+// [asn1.RawValue] and whether the type contains options at all.
+func asn1Mirror(t reflect.Type) (reflect.Type, bool) {
+	if cached, ok := asn1Mirrors.Load(t); ok {
+		mirror, _ := cached.(reflect.Type)
+
+		return mirror, mirror != t
+	}
+
+	mirror := buildASN1Mirror(t)
+	asn1Mirrors.Store(t, mirror)
+
+	return mirror, mirror != t
+}
+
+func buildASN1Mirror(t reflect.Type) reflect.Type {
+	if reflect.PointerTo(t).Implements(asn1OptionType) {
+		return asn1RawValueType
+	}
+
+	switch t.Kind() {
+	case reflect.Slice:
+		if elem, changed := asn1Mirror(t.Elem()); changed {
+			return reflect.SliceOf(elem)
+		}
+	case reflect.Struct:
+		fields := make([]reflect.StructField, t.NumField())
+		changed := false
+
+		for i := range fields {
+			field := t.Field(i)
+			if field.PkgPath != "" {
+				// leave it to asn1 to complain about unexported fields.
+				return t
+			}
+
+			mirror, fieldChanged := asn1Mirror(field.Type)
+			if fieldChanged && mirror == asn1RawValueType {
+				field.Tag = asn1OptionalTag(field.Tag)
+			}
+
+			field.Type, changed = mirror, changed || fieldChanged
+			fields[i] = field
+		}
+
+		if changed {
+			return reflect.StructOf(fields)
+		}
+	default:
+	}
+
+	return t
+}
+
+// asn1OptionalTag marks the field as OPTIONAL.
+func asn1OptionalTag(tag reflect.StructTag) reflect.StructTag {
+	params, ok := tag.Lookup("asn1")
+	if !ok {
+		return reflect.StructTag(strings.TrimSpace(string(tag) + ` asn1:"optional"`))
+	}
+
+	for _, param := range strings.Split(params, ",") {
+		if param == "optional" {
+			return tag
+		}
+	}
+
+	return reflect.StructTag(strings.Replace(
+		string(tag), `asn1:"`+params+`"`, `asn1:"`+params+`,optional"`, 1,
+	))
+}
+
+// asn1Params returns the asn1 field parameters without optional,
+// which are applied to the value of options.
+func asn1Params(field reflect.StructField) string {
+	var params []string
+
+	for _, param := range strings.Split(field.Tag.Get("asn1"), ",") {
+		if param != "" && param != "optional" {
+			params = append(params, param)
+		}
+	}
+
+	return strings.Join(params, ",")
+}
+
+func toASN1Mirror(rv, mirror reflect.Value, params string) error {
+	switch {
+	case mirror.Type() == rv.Type():
+		mirror.Set(rv)
+	case mirror.Type() == asn1RawValueType:
+		raw, err := addressable(rv).Addr().Interface().(asn1Option).marshalASN1(params)
+		if err != nil {
+			return err
+		}
+
+		mirror.Set(reflect.ValueOf(raw))
+	case rv.Kind() == reflect.Slice:
+		mirror.Set(reflect.MakeSlice(mirror.Type(), rv.Len(), rv.Len()))
+
+		for i := 0; i < rv.Len(); i++ {
+			err := toASN1Mirror(rv.Index(i), mirror.Index(i), "")
+			if err != nil {
+				return err
+			}
+		}
+	case rv.Kind() == reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			err := toASN1Mirror(rv.Field(i), mirror.Field(i), asn1Params(rv.Type().Field(i)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func fromASN1Mirror(mirror, rv reflect.Value, params string) error {
+	switch {
+	case mirror.Type() == rv.Type():
+		rv.Set(mirror)
+	case mirror.Type() == asn1RawValueType:
+		raw, _ := mirror.Interface().(asn1.RawValue)
+
+		return rv.Addr().Interface().(asn1Option).unmarshalASN1(raw, params)
+	case rv.Kind() == reflect.Slice:
+		rv.Set(reflect.MakeSlice(rv.Type(), mirror.Len(), mirror.Len()))
+
+		for i := 0; i < mirror.Len(); i++ {
+			err := fromASN1Mirror(mirror.Index(i), rv.Index(i), "")
+			if err != nil {
+				return err
+			}
+		}
+	case rv.Kind() == reflect.Struct:
+		for i := 0; i < rv.NumField(); i++ {
+			err := fromASN1Mirror(mirror.Field(i), rv.Field(i), asn1Params(rv.Type().Field(i)))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+package opt_test
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+type TBSCertificate struct {
+	Version      opt.T[int] `asn1:"explicit,tag:0"`
+	SerialNumber int64
+	SubjectID    opt.T[asn1.BitString] `asn1:"tag:2"`
+	Extensions   opt.T[[]Extension]    `asn1:"explicit,tag:3"`
+}
+
+type Extension struct {
+	ID       asn1.ObjectIdentifier
+	Critical bool `asn1:"optional"`
+	Value    []byte
+}
+
+func ExampleMarshalASN1() {
+	data, _ := opt.MarshalASN1(TBSCertificate{
+		Version:      opt.Some(2),
+		SerialNumber: 42,
+		SubjectID:    opt.None[asn1.BitString](),
+		Extensions:   opt.None[[]Extension](),
+	})
+
+	fmt.Println(hex.EncodeToString(data))
+	// Output: 3008a00302010202012a
+}
+
+func ExampleUnmarshalASN1() {
+	data, _ := hex.DecodeString("3008a00302010202012a")
+
+	var tbs TBSCertificate
+
+	_, _ = opt.UnmarshalASN1(data, &tbs)
+
+	fmt.Println(tbs.Version, tbs.SerialNumber, tbs.SubjectID.IsPresent(), tbs.Extensions.IsPresent())
+	// Output: Some[int](2) 42 false false
+}
+
+// tbsCertificate is the equivalent of [TBSCertificate] using plain encoding/asn1.
+type tbsCertificate struct {
+	Version      int `asn1:"optional,explicit,tag:0"`
+	SerialNumber int64
+	SubjectID    asn1.BitString `asn1:"optional,tag:2"`
+	Extensions   []Extension    `asn1:"optional,explicit,tag:3"`
+}
+
+func TestMarshalASN1LikeOptional(t *testing.T) {
+	plain := tbsCertificate{
+		Version:      2,
+		SerialNumber: 7,
+		SubjectID:    asn1.BitString{Bytes: []byte{0xa0}, BitLength: 3},
+		Extensions: []Extension{
+			{ID: asn1.ObjectIdentifier{2, 5, 29, 19}, Critical: true, Value: []byte{0x30, 0x00}},
+		},
+	}
+
+	want, err := asn1.Marshal(plain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tbs := TBSCertificate{
+		Version:      opt.Some(plain.Version),
+		SerialNumber: plain.SerialNumber,
+		SubjectID:    opt.Some(plain.SubjectID),
+		Extensions:   opt.Some(plain.Extensions),
+	}
+
+	got, err := opt.MarshalASN1(tbs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if hex.EncodeToString(got) != hex.EncodeToString(want) {
+		t.Errorf("marshaled %x, want %x", got, want)
+	}
+
+	var decoded TBSCertificate
+
+	rest, err := opt.UnmarshalASN1(want, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(rest) != 0 || !reflect.DeepEqual(decoded, tbs) {
+		t.Errorf("unmarshaled %+v, want %+v", decoded, tbs)
+	}
+}
+
+type AlgorithmIdentifier struct {
+	Algorithm  asn1.ObjectIdentifier
+	Parameters opt.T[asn1.RawValue]
+}
+
+func TestASN1UntaggedTrailing(t *testing.T) {
+	for _, algorithm := range []AlgorithmIdentifier{
+		{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 10045, 4, 3, 2}, Parameters: opt.None[asn1.RawValue]()},
+		{Algorithm: asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 1, 11}, Parameters: opt.Some(asn1.NullRawValue)},
+	} {
+		data, err := opt.MarshalASN1(algorithm)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var decoded AlgorithmIdentifier
+
+		_, err = opt.UnmarshalASN1(data, &decoded)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if decoded.Parameters.IsPresent() != algorithm.Parameters.IsPresent() ||
+			!decoded.Algorithm.Equal(algorithm.Algorithm) {
+			t.Errorf("unmarshaled %+v, want %+v", decoded, algorithm)
+		}
+	}
+}
+
+func TestASN1Identity(t *testing.T) {
+	type Record struct {
+		ID    int64
+		Items []struct {
+			Label opt.T[string] `asn1:"explicit,utf8,tag:0"`
+		}
+		Name  opt.T[string] `asn1:"utf8,tag:0"`
+		Score opt.T[int]    `asn1:"explicit,tag:1"`
+	}
+
+	err := quick.Check(func(id int64, name opt.T[string], score opt.T[int], labels []opt.T[string]) bool {
+		record := Record{ID: id, Name: name, Score: score, Items: nil}
+
+		for _, label := range labels {
+			record.Items = append(record.Items, struct {
+				Label opt.T[string] `asn1:"explicit,utf8,tag:0"`
+			}{label})
+		}
+
+		data, err := opt.MarshalASN1(record)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		var decoded Record
+
+		_, err = opt.UnmarshalASN1(data, &decoded)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if len(decoded.Items) == 0 && len(record.Items) == 0 {
+			decoded.Items = record.Items
+		}
+
+		return reflect.DeepEqual(decoded, record)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnmarshalASN1Invalid(t *testing.T) {
+	var tbs TBSCertificate
+
+	// version is explicitly tagged, but contains a string.
+	data, _ := hex.DecodeString("300aa0050c03666f6f02012a")
+
+	_, err := opt.UnmarshalASN1(data, &tbs)
+	if err == nil {
+		t.Errorf("expected error, got %+v", tbs)
+	}
+}
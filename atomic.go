@@ -0,0 +1,58 @@
+package opt
+
+import "sync/atomic"
+
+// Atomic is an option that can be loaded and stored atomically,
+// without the use of locks.
+//
+// The zero value is an empty option.
+// An Atomic must not be copied after first use.
+type Atomic[V any] struct {
+	p atomic.Pointer[T[V]]
+}
+
+// Load atomically loads the option.
+func (a *Atomic[V]) Load() T[V] {
+	return deref(a.p.Load())
+}
+
+// Store atomically stores the option.
+func (a *Atomic[V]) Store(t T[V]) {
+	a.p.Store(&t)
+}
+
+// Swap atomically stores the new option and returns the previous one.
+func (a *Atomic[V]) Swap(t T[V]) T[V] {
+	return deref(a.p.Swap(&t))
+}
+
+// CompareAndSwap atomically stores the new option,
+// if the current option is equal to old, see [Equal],
+// and returns whether the new option was stored.
+//
+// CompareAndSwap panics if the wrapped values are present but not comparable,
+// similar to [sync.Map.CompareAndSwap].
+func (a *Atomic[V]) CompareAndSwap(old, new T[V]) bool {
+	for {
+		current := a.p.Load()
+		if !EqualFunc(deref(current), old, equalAny[V]) {
+			return false
+		}
+
+		if a.p.CompareAndSwap(current, &new) {
+			return true
+		}
+	}
+}
+
+func deref[V any](t *T[V]) T[V] {
+	if t == nil {
+		return None[V]()
+	}
+
+	return *t
+}
+
+func equalAny[V any](a, b V) bool {
+	return any(a) == any(b)
+}
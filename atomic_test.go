@@ -0,0 +1,57 @@
+package opt_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleAtomic() {
+	var token opt.Atomic[string]
+
+	fmt.Println(token.Load())
+
+	token.Store(opt.Some("first"))
+	fmt.Println(token.Swap(opt.Some("second")))
+
+	fmt.Println(token.CompareAndSwap(opt.Some("first"), opt.None[string]()))
+	fmt.Println(token.CompareAndSwap(opt.Some("second"), opt.None[string]()))
+	fmt.Println(token.Load())
+	// Output: None[string]()
+	// Some[string](first)
+	// false
+	// true
+	// None[string]()
+}
+
+func TestAtomicCompareAndSwapConcurrent(t *testing.T) {
+	var (
+		counter opt.Atomic[int]
+		wg      sync.WaitGroup
+	)
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for j := 0; j < 100; j++ {
+				for {
+					old := counter.Load()
+					if counter.CompareAndSwap(old, opt.Some(old.OrZero()+1)) {
+						break
+					}
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if got := counter.Load(); !opt.Contains(got, 1600) {
+		t.Fatalf("expected Some(1600), got %s", got)
+	}
+}
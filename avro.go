@@ -0,0 +1,166 @@
+package opt
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"time"
+)
+
+// Avro Union Conversion.
+var _ Optional = &AvroUnion[any]{}
+
+// AvroUnion is an option, that implements the UnionConverter of github.com/hamba/avro/v2,
+// encoding empty options as null and present values as the non-null branch.
+//
+// Note that the union converter is only considered for pointer fields,
+// i.e. use *AvroUnion[V] with a union schema as returned by [AvroSchema].
+type AvroUnion[V any] struct {
+	T[V]
+}
+
+// ToAny implements the UnionConverter of github.com/hamba/avro/v2.
+func (u *AvroUnion[V]) ToAny() (any, error) {
+	value, present := u.GetAny()
+	if !present {
+		return new(any), nil
+	}
+
+	return &value, nil
+}
+
+// FromAny implements the UnionConverter of github.com/hamba/avro/v2.
+//
+// Numbers are converted to V, if necessary,
+// rejecting values that overflow V and non-integral values for integers.
+func (u *AvroUnion[V]) FromAny(payload any) error {
+	if u.SetAny(payload) == nil {
+		return nil
+	}
+
+	return u.setNumber(payload)
+}
+
+// setNumber sets the option to the number payload converted to V.
+func (t *T[V]) setNumber(payload any) error {
+	var value V
+
+	rv := reflect.ValueOf(&value).Elem()
+	rp := reflect.ValueOf(payload)
+
+	if !isNumber(rv.Kind()) || !isNumber(rp.Kind()) {
+		return fmt.Errorf("opt: cannot convert %T to %s", payload, rv.Type())
+	}
+
+	err := convertNumber(rv, rp)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
+
+// AvroSchema returns the avro schema of options of V, i.e. a union of null and V.
+//
+// Primitive types and [time.Time] are derived, otherwise the schema of V must be given.
+func AvroSchema[V any](schema ...json.RawMessage) ([]byte, error) {
+	if len(schema) > 0 {
+		return json.Marshal([]any{"null", schema[0]})
+	}
+
+	var value V
+
+	if _, ok := any(value).(time.Time); ok {
+		return json.Marshal([]any{"null", map[string]string{"type": "long", "logicalType": "timestamp-micros"}})
+	}
+
+	rv := reflect.ValueOf(&value).Elem()
+
+	var name string
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		name = "boolean"
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint8, reflect.Uint16:
+		name = "int"
+	case reflect.Int, reflect.Int64, reflect.Uint32:
+		name = "long"
+	case reflect.Float32:
+		name = "float"
+	case reflect.Float64:
+		name = "double"
+	case reflect.String:
+		name = "string"
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, fmt.Errorf("opt: cannot derive avro schema of %s", rv.Type())
+		}
+
+		name = "bytes"
+	default:
+		return nil, fmt.Errorf("opt: cannot derive avro schema of %s", rv.Type())
+	}
+
+	return json.Marshal([]any{"null", name})
+}
+
+// convertNumber sets rv to the number rp, if it is representable by the type of rv.
+func convertNumber(rv, rp reflect.Value) error {
+	switch {
+	case isFloat(rp.Kind()) && !isFloat(rv.Kind()) && rp.Float() != math.Trunc(rp.Float()):
+		return fmt.Errorf("opt: %v is not an integer", rp)
+	case isInt(rv.Kind()) && (isUint(rp.Kind()) && rp.Uint() > math.MaxInt64 ||
+		isFloat(rp.Kind()) && (rp.Float() < math.MinInt64 || rp.Float() >= math.MaxInt64)):
+		return fmt.Errorf("opt: %v overflows %s", rp, rv.Type())
+	case isUint(rv.Kind()) && (isInt(rp.Kind()) && rp.Int() < 0 ||
+		isFloat(rp.Kind()) && (rp.Float() < 0 || rp.Float() >= math.MaxUint64)):
+		return fmt.Errorf("opt: %v overflows %s", rp, rv.Type())
+	}
+
+	converted := rp.Convert(rv.Type())
+
+	var overflow bool
+
+	switch {
+	case isInt(rv.Kind()):
+		overflow = rv.OverflowInt(rp.Convert(reflect.TypeOf(int64(0))).Int())
+	case isUint(rv.Kind()):
+		overflow = rv.OverflowUint(rp.Convert(reflect.TypeOf(uint64(0))).Uint())
+	default:
+		overflow = rv.OverflowFloat(rp.Convert(reflect.TypeOf(float64(0))).Float())
+	}
+
+	if overflow {
+		return fmt.Errorf("opt: %v overflows %s", rp, rv.Type())
+	}
+
+	rv.Set(converted)
+
+	return nil
+}
+
+func isInt(kind reflect.Kind) bool {
+	return kind >= reflect.Int && kind <= reflect.Int64
+}
+
+func isUint(kind reflect.Kind) bool {
+	return kind >= reflect.Uint && kind <= reflect.Uint64
+}
+
+func isFloat(kind reflect.Kind) bool {
+	return kind == reflect.Float32 || kind == reflect.Float64
+}
+
+func isNumber(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return true
+	default:
+		return false
+	}
+}
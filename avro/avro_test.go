@@ -0,0 +1,114 @@
+package avro_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/hamba/avro/v2"
+
+	"github.com/lukasngl/opt"
+)
+
+// Event uses pointer fields, as hamba/avro only considers union converters for pointers.
+type Event struct {
+	ID      int64                   `avro:"id"`
+	User    *opt.AvroUnion[string]  `avro:"user"`
+	Retries *opt.AvroUnion[int32]   `avro:"retries"`
+	Score   *opt.AvroUnion[float64] `avro:"score"`
+}
+
+func schema(t *testing.T) avro.Schema {
+	t.Helper()
+
+	user, err := opt.AvroSchema[string]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	retries, err := opt.AvroSchema[int32]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	score, err := opt.AvroSchema[float64]()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return avro.MustParse(fmt.Sprintf(`{"type":"record","name":"Event","fields":[
+		{"name":"id","type":"long"},
+		{"name":"user","type":%s},
+		{"name":"retries","type":%s},
+		{"name":"score","type":%s}
+	]}`, user, retries, score))
+}
+
+func TestMarshal(t *testing.T) {
+	user := opt.AvroUnion[string]{T: opt.Some("gopher")}
+	retries := opt.AvroUnion[int32]{T: opt.None[int32]()}
+	score := opt.AvroUnion[float64]{T: opt.Some(0.5)}
+
+	data, err := avro.Marshal(schema(t), Event{ID: 1, User: &user, Retries: &retries, Score: &score})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// id, branch 1 with "gopher", branch 0, branch 1 with 0.5
+	want := []byte{2, 2, 12, 'g', 'o', 'p', 'h', 'e', 'r', 0, 2, 0, 0, 0, 0, 0, 0, 0xe0, 0x3f}
+	if string(data) != string(want) {
+		t.Fatalf("got %v, want %v", data, want)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	schema := schema(t)
+
+	err := quick.Check(func(id int64, user opt.T[string], retries opt.T[int32], score opt.T[float64]) bool {
+		var de Event
+
+		ser := Event{
+			ID:      id,
+			User:    &opt.AvroUnion[string]{T: user},
+			Retries: &opt.AvroUnion[int32]{T: retries},
+			Score:   &opt.AvroUnion[float64]{T: score},
+		}
+
+		data, err := avro.Marshal(schema, ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = avro.Unmarshal(schema, data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		// a null branch is decoded as nil pointer
+		equal := de.ID == ser.ID &&
+			opt.Equal(unwrap(de.User), user) &&
+			opt.Equal(unwrap(de.Retries), retries) &&
+			opt.EqualFunc(unwrap(de.Score), score, func(a, b float64) bool {
+				return a == b || a != a && b != b
+			})
+		if !equal {
+			t.Logf("ser: %v %v %v %v", ser.ID, user, retries, score)
+			t.Logf("de: %v %v %v %v", de.ID, de.User, de.Retries, de.Score)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func unwrap[V any](union *opt.AvroUnion[V]) opt.T[V] {
+	if union == nil {
+		return opt.None[V]()
+	}
+
+	return union.T
+}
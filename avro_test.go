@@ -0,0 +1,89 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleAvroSchema() {
+	name, _ := opt.AvroSchema[string]()
+	createdAt, _ := opt.AvroSchema[time.Time]()
+	address, _ := opt.AvroSchema[struct{ Street string }](json.RawMessage(
+		`{"type":"record","name":"Address","fields":[{"name":"Street","type":"string"}]}`,
+	))
+
+	fmt.Println(string(name))
+	fmt.Println(string(createdAt))
+	fmt.Println(string(address))
+	// Output:
+	// ["null","string"]
+	// ["null",{"logicalType":"timestamp-micros","type":"long"}]
+	// ["null",{"type":"record","name":"Address","fields":[{"name":"Street","type":"string"}]}]
+}
+
+func TestAvroSchemaUnsupported(t *testing.T) {
+	_, err := opt.AvroSchema[map[string]int]()
+	if err == nil {
+		t.Fatal("expected error deriving the schema of a map")
+	}
+}
+
+func TestFromAny(t *testing.T) {
+	var value opt.AvroUnion[int32]
+
+	err := value.FromAny(int64(42))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Contains(value.T, 42) {
+		t.Fatalf("expected Some(42), got %s", value)
+	}
+
+	err = value.FromAny(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value.IsPresent() {
+		t.Fatalf("expected None, got %s", value)
+	}
+
+	err = value.FromAny("42")
+	if err == nil {
+		t.Fatal("expected error converting a string to int32")
+	}
+}
+
+func TestFromAnyOverflow(t *testing.T) {
+	for name, convert := range map[string]func() error{
+		"int64 to int32":   func() error { return new(opt.AvroUnion[int32]).FromAny(int64(1 << 40)) },
+		"int to int16":     func() error { return new(opt.AvroUnion[int16]).FromAny(70000) },
+		"negative to uint": func() error { return new(opt.AvroUnion[uint8]).FromAny(-1) },
+		"uint64 to int64":  func() error { return new(opt.AvroUnion[int64]).FromAny(uint64(1 << 63)) },
+		"fraction to int":  func() error { return new(opt.AvroUnion[int]).FromAny(1.9) },
+		"float to uint8":   func() error { return new(opt.AvroUnion[uint8]).FromAny(256.0) },
+		"float to float32": func() error { return new(opt.AvroUnion[float32]).FromAny(1e300) },
+	} {
+		t.Run(name, func(t *testing.T) {
+			if convert() == nil {
+				t.Fatal("expected error converting a number not representable by V")
+			}
+		})
+	}
+
+	var value opt.AvroUnion[uint8]
+
+	err := value.FromAny(255.0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Contains(value.T, 255) {
+		t.Fatalf("expected Some(255), got %s", value)
+	}
+}
@@ -0,0 +1,98 @@
+package opt
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+)
+
+// Binary Marshalling und Unmarshalling.
+var (
+	_ encoding.BinaryMarshaler   = T[any]{}
+	_ encoding.BinaryUnmarshaler = &T[any]{}
+)
+
+const (
+	binaryNone byte = iota
+	binarySome
+)
+
+// ErrInvalidBinary is returned when unmarshalling malformed binary data.
+var ErrInvalidBinary = errors.New("opt: invalid binary encoding")
+
+// MarshalBinary implements [encoding.BinaryMarshaler].
+//
+// The encoding consists of a presence byte, followed by the encoded value if present:
+//
+//  1. If the value implements [encoding.BinaryMarshaler], even if implemented by *V, it is used,
+//  2. otherwise the value is encoded using [encoding/gob].
+func (t T[V]) MarshalBinary() ([]byte, error) {
+	return t.encodeTagged(marshalBinary[V])
+}
+
+// UnmarshalBinary implements [encoding.BinaryUnmarshaler].
+func (t *T[V]) UnmarshalBinary(data []byte) error {
+	return t.decodeTagged(data, unmarshalBinary[V])
+}
+
+// encodeTagged encodes the presence byte followed by the value encoded with encode if present.
+func (t T[V]) encodeTagged(encode func(V) ([]byte, error)) ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return []byte{binaryNone}, nil
+	}
+
+	payload, err := encode(value)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{binarySome}, payload...), nil
+}
+
+// decodeTagged is the inverse of [T.encodeTagged].
+func (t *T[V]) decodeTagged(data []byte, decode func([]byte, *V) error) error {
+	if len(data) == 0 {
+		return ErrInvalidBinary
+	}
+
+	switch data[0] {
+	case binaryNone:
+		if len(data) != 1 {
+			return ErrInvalidBinary
+		}
+
+		*t = None[V]()
+
+		return nil
+	case binarySome:
+		var value V
+
+		err := decode(data[1:], &value)
+		if err != nil {
+			return err
+		}
+
+		*t = Some(value)
+
+		return nil
+	default:
+		return fmt.Errorf("%w: unknown presence byte %#x", ErrInvalidBinary, data[0])
+	}
+}
+
+func marshalBinary[V any](value V) ([]byte, error) {
+	if marshaler, ok := any(&value).(encoding.BinaryMarshaler); ok {
+		return marshaler.MarshalBinary()
+	}
+
+	return gobEncode(value)
+}
+
+func unmarshalBinary[V any](data []byte, value *V) error {
+	if unmarshaler, ok := any(value).(encoding.BinaryUnmarshaler); ok {
+		return unmarshaler.UnmarshalBinary(data)
+	}
+
+	return gobDecode(data, value)
+}
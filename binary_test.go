@@ -0,0 +1,103 @@
+package opt_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_MarshalBinary() {
+	none, _ := opt.None[string]().MarshalBinary()
+	fmt.Printf("%v\n", none)
+
+	var decoded opt.T[time.Time]
+
+	data, _ := opt.Some(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)).MarshalBinary()
+	_ = decoded.UnmarshalBinary(data)
+	fmt.Println(decoded)
+	// Output: [0]
+	// Some[time.Time](2024-01-01 00:00:00 +0000 UTC)
+}
+
+func TestUnmarshalBinaryInvalid(t *testing.T) {
+	for _, input := range [][]byte{{}, {0, 1}, {2}} {
+		var value opt.T[int]
+
+		err := value.UnmarshalBinary(input)
+		if !errors.Is(err, opt.ErrInvalidBinary) {
+			t.Errorf("unmarshal %v: expected %v, got %v", input, opt.ErrInvalidBinary, err)
+		}
+	}
+}
+
+func TestBinaryIdentity(t *testing.T) {
+	err := quick.Check(func(ser Thing) bool {
+		var de opt.T[Thing]
+
+		data, err := opt.Some(ser).MarshalBinary()
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = de.UnmarshalBinary(data)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return de == opt.Some(ser)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Version implements [encoding.BinaryMarshaler] and [encoding.BinaryUnmarshaler] on its pointer,
+// while gob cannot encode it, as it has no exported fields.
+type Version struct {
+	major, minor uint8
+}
+
+func (v *Version) MarshalBinary() ([]byte, error) {
+	return []byte{'v', v.major, v.minor}, nil
+}
+
+func (v *Version) UnmarshalBinary(data []byte) error {
+	if len(data) != 3 || data[0] != 'v' {
+		return fmt.Errorf("invalid version %v", data)
+	}
+
+	v.major, v.minor = data[1], data[2]
+
+	return nil
+}
+
+func TestBinaryPointerMarshaler(t *testing.T) {
+	want := opt.Some(Version{major: 1, minor: 2})
+
+	data, err := want.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, []byte{1, 'v', 1, 2}) {
+		t.Errorf("marshalled %v, want %v", data, []byte{1, 'v', 1, 2})
+	}
+
+	var got opt.T[Version]
+
+	err = got.UnmarshalBinary(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != want {
+		t.Errorf("unmarshalled %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,49 @@
+package opt
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RawBytes is an option of bytes, that already contain JSON,
+// which are embedded as is, instead of being encoded as a base64 string.
+//
+// Like [json.RawMessage], but null is decoded as an empty option.
+type RawBytes struct {
+	T[[]byte]
+}
+
+// JSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &RawBytes{}
+	_ json.Marshaler   = RawBytes{}
+)
+
+// MarshalJSON implements [json.Marshaler].
+//
+// An error is returned, if the bytes are not valid JSON.
+func (r RawBytes) MarshalJSON() ([]byte, error) {
+	data, present := r.Unwrap()
+	if !present {
+		return []byte("null"), nil
+	}
+
+	if !json.Valid(data) {
+		return nil, fmt.Errorf("opt: invalid raw JSON %q", data)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (r *RawBytes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		r.T = None[[]byte]()
+
+		return nil
+	}
+
+	r.T = Some(append([]byte(nil), data...))
+
+	return nil
+}
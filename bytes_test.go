@@ -0,0 +1,122 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+type Attachment struct {
+	Content opt.T[[]byte] `json:"content"`
+	Meta    opt.RawBytes  `json:"meta"`
+}
+
+func ExampleRawBytes() {
+	data, _ := json.Marshal(Attachment{
+		Content: opt.Some([]byte("hello")),
+		Meta:    opt.RawBytes{T: opt.Some([]byte(`{"type":"text/plain"}`))},
+	})
+
+	fmt.Println(string(data))
+
+	var attachment Attachment
+
+	_ = json.Unmarshal([]byte(`{"content":null,"meta":[1, 2]}`), &attachment)
+
+	fmt.Println(attachment.Content, string(attachment.Meta.Must()))
+	// Output: {"content":"aGVsbG8=","meta":{"type":"text/plain"}}
+	// None[[]uint8]() [1, 2]
+}
+
+func TestBytesJSONLikePlain(t *testing.T) {
+	err := quick.Check(func(input []byte) bool {
+		if input == nil {
+			input = []byte{}
+		}
+
+		want, _ := json.Marshal(input)
+
+		got, err := json.Marshal(opt.Some(input))
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if string(got) != string(want) {
+			t.Logf("%v marshaled to %s, want %s", input, got, want)
+			return false
+		}
+
+		var output opt.T[[]byte]
+
+		err = json.Unmarshal(got, &output)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return string(output.Must()) == string(input)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestBytesJSONNil(t *testing.T) {
+	data, err := json.Marshal(opt.Some([]byte(nil)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output opt.T[[]byte]
+
+	err = json.Unmarshal(data, &output)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `""` || !output.IsPresent() {
+		t.Errorf("expected present nil bytes to round-trip as present, got %s and %v", data, output)
+	}
+
+	// named byte slices keep their own encoding.
+	data, _ = json.Marshal(opt.Some(json.RawMessage(nil)))
+	if string(data) != "null" {
+		t.Errorf("expected nil raw message to be encoded as null, got %s", data)
+	}
+}
+
+func TestBytesText(t *testing.T) {
+	data, err := opt.Some([]byte("café")).MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var output opt.T[[]byte]
+
+	err = output.UnmarshalText(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "café" || string(output.Must()) != "café" {
+		t.Errorf("expected bytes to be used as text, got %q and %v", data, output)
+	}
+}
+
+func TestRawBytesInvalid(t *testing.T) {
+	_, err := json.Marshal(opt.RawBytes{T: opt.Some([]byte("{"))})
+	if err == nil {
+		t.Error("expected error marshaling invalid raw JSON")
+	}
+
+	var raw opt.RawBytes
+
+	err = json.Unmarshal([]byte("null"), &raw)
+	if err != nil || raw.IsPresent() {
+		t.Errorf("expected null to decode as empty option, got %v, %v", raw, err)
+	}
+}
@@ -0,0 +1,66 @@
+package opt
+
+import "sync"
+
+// Cell is an option guarded by a mutex,
+// for sharing mutable optional state between goroutines.
+//
+// The zero value is an empty option.
+// A Cell must not be copied after first use.
+type Cell[V any] struct {
+	mu sync.Mutex
+	t  T[V]
+}
+
+// Load returns the option.
+func (c *Cell[V]) Load() T[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.t
+}
+
+// Store replaces the option.
+func (c *Cell[V]) Store(t T[V]) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.t = t
+}
+
+// With replaces the option with the result of fn applied to the current option,
+// while holding the lock, and returns the new option.
+//
+// fn must not access the cell itself, as this would deadlock.
+func (c *Cell[V]) With(fn func(T[V]) T[V]) T[V] {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.t = fn(c.t)
+
+	return c.t
+}
+
+// SetIfEmpty wraps the given value if the option is empty,
+// and returns whether the value was set.
+func (c *Cell[V]) SetIfEmpty(value V) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.t.present {
+		return false
+	}
+
+	c.t.Set(value)
+
+	return true
+}
+
+// TakeIfPresent returns the wrapped value and whether it is present,
+// leaving an empty option in its place, see [T.Take].
+func (c *Cell[V]) TakeIfPresent() (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.t.Take()
+}
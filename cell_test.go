@@ -0,0 +1,65 @@
+package opt_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleCell() {
+	var job opt.Cell[string]
+
+	fmt.Println(job.SetIfEmpty("first"))
+	fmt.Println(job.SetIfEmpty("second"))
+	fmt.Println(job.TakeIfPresent())
+	fmt.Println(job.TakeIfPresent())
+	// Output: true
+	// false
+	// first true
+	//  false
+}
+
+func ExampleCell_With() {
+	var counter opt.Cell[int]
+
+	increment := func(t opt.T[int]) opt.T[int] {
+		return opt.Some(t.OrZero() + 1)
+	}
+
+	counter.With(increment)
+	fmt.Println(counter.With(increment))
+	// Output: Some[int](2)
+}
+
+func TestCellTakeIfPresentConcurrent(t *testing.T) {
+	var (
+		cell  opt.Cell[int]
+		wg    sync.WaitGroup
+		mu    sync.Mutex
+		taken int
+	)
+
+	cell.Store(opt.Some(42))
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, present := cell.TakeIfPresent(); present {
+				mu.Lock()
+				taken++
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if taken != 1 {
+		t.Fatalf("expected value to be taken exactly once, got %d", taken)
+	}
+}
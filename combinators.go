@@ -0,0 +1,218 @@
+package opt
+
+// Map applies fn to the wrapped value if present,
+// and returns an empty option otherwise.
+//
+// Go does not allow type parameters on methods,
+// thus Map is a function rather than a method of [T].
+func Map[V, W any](t T[V], fn func(V) W) T[W] {
+	value, present := t.Unwrap()
+	if !present {
+		return None[W]()
+	}
+
+	return Some(fn(value))
+}
+
+// FlatMap applies fn to the wrapped value if present,
+// and returns an empty option otherwise.
+//
+// In contrast to [Map], fn itself returns an option,
+// which allows chaining lookups that may fail.
+func FlatMap[V, W any](t T[V], fn func(V) T[W]) T[W] {
+	value, present := t.Unwrap()
+	if !present {
+		return None[W]()
+	}
+
+	return fn(value)
+}
+
+// AndThen is the method form of [FlatMap],
+// restricted to functions that do not change the type.
+func (t T[V]) AndThen(fn func(V) T[V]) T[V] {
+	return FlatMap(t, fn)
+}
+
+// Filter returns the option if it is present and keep returns true
+// for the wrapped value, otherwise an empty option is returned.
+func (t T[V]) Filter(keep func(V) bool) T[V] {
+	value, present := t.Unwrap()
+	if !present || !keep(value) {
+		return None[V]()
+	}
+
+	return t
+}
+
+// Match returns the result of onSome applied to the wrapped value if present,
+// and the result of onNone otherwise.
+func Match[V, R any](t T[V], onSome func(V) R, onNone func() R) R {
+	value, present := t.Unwrap()
+	if !present {
+		return onNone()
+	}
+
+	return onSome(value)
+}
+
+// Match calls onSome with the wrapped value if present,
+// and onNone otherwise.
+//
+// See [Match] for a variant that returns a result.
+func (t T[V]) Match(onSome func(V), onNone func()) {
+	value, present := t.Unwrap()
+	if !present {
+		onNone()
+
+		return
+	}
+
+	onSome(value)
+}
+
+// IfPresent calls fn with the wrapped value if present.
+// It returns the receiver to allow chaining.
+func (t T[V]) IfPresent(fn func(V)) T[V] {
+	value, present := t.Unwrap()
+	if present {
+		fn(value)
+	}
+
+	return t
+}
+
+// Inspect calls fn with the wrapped value if present,
+// and returns the receiver, which is the same as [T.IfPresent].
+//
+// It is meant for read-only peeks in the middle of a chain,
+// e.g. for logging.
+func (t T[V]) Inspect(fn func(V)) T[V] {
+	return t.IfPresent(fn)
+}
+
+// IfEmpty calls fn if the option is empty.
+// It returns the receiver to allow chaining.
+func (t T[V]) IfEmpty(fn func()) T[V] {
+	if !t.present {
+		fn()
+	}
+
+	return t
+}
+
+// Flatten collapses a nested option,
+// which is present only if both the outer and the inner option are present.
+func Flatten[V any](t T[T[V]]) T[V] {
+	inner, present := t.Unwrap()
+	if !present {
+		return None[V]()
+	}
+
+	return inner
+}
+
+// Or returns the option if it is present, and other otherwise.
+func (t T[V]) Or(other T[V]) T[V] {
+	if !t.present {
+		return other
+	}
+
+	return t
+}
+
+// OrFunc returns the option if it is present,
+// and the result of fn otherwise.
+//
+// In contrast to [T.Or], the fallback is only computed when needed.
+func (t T[V]) OrFunc(fn func() T[V]) T[V] {
+	if !t.present {
+		return fn()
+	}
+
+	return t
+}
+
+// And returns b if a is present, and an empty option otherwise.
+func And[A, B any](a T[A], b T[B]) T[B] {
+	if !a.present {
+		return None[B]()
+	}
+
+	return b
+}
+
+// Xor returns the present option if exactly one of a and b is present,
+// and an empty option otherwise.
+func Xor[V any](a, b T[V]) T[V] {
+	switch {
+	case a.present && !b.present:
+		return a
+	case !a.present && b.present:
+		return b
+	default:
+		return None[V]()
+	}
+}
+
+// Coalesce returns the first present option,
+// or an empty option if none is present.
+func Coalesce[V any](opts ...T[V]) T[V] {
+	for _, t := range opts {
+		if t.present {
+			return t
+		}
+	}
+
+	return None[V]()
+}
+
+// Lift2 turns a function of two values into a function of two options,
+// which returns an empty option if any argument is empty.
+func Lift2[A, B, R any](fn func(A, B) R) func(T[A], T[B]) T[R] {
+	return func(a T[A], b T[B]) T[R] {
+		return Map(Zip(a, b), func(p Pair[A, B]) R {
+			return fn(p.First, p.Second)
+		})
+	}
+}
+
+// Lift3 turns a function of three values into a function of three options,
+// which returns an empty option if any argument is empty.
+func Lift3[A, B, C, R any](fn func(A, B, C) R) func(T[A], T[B], T[C]) T[R] {
+	return func(a T[A], b T[B], c T[C]) T[R] {
+		return Map(Zip3(a, b, c), func(t Triple[A, B, C]) R {
+			return fn(t.First, t.Second, t.Third)
+		})
+	}
+}
+
+// MapErr is like [Map], but for functions that may fail.
+//
+// If fn returns an error, an empty option and the error are returned.
+func MapErr[V, W any](t T[V], fn func(V) (W, error)) (T[W], error) {
+	value, present := t.Unwrap()
+	if !present {
+		return None[W](), nil
+	}
+
+	result, err := fn(value)
+	if err != nil {
+		return None[W](), err
+	}
+
+	return Some(result), nil
+}
+
+// Pipe2 chains two option-returning functions, see [FlatMap].
+//
+// For functions that do not change the type,
+// the methods [T.AndThen] and [T.Filter] can be chained directly.
+func Pipe2[A, B, C any](t T[A], f func(A) T[B], g func(B) T[C]) T[C] {
+	return FlatMap(FlatMap(t, f), g)
+}
+
+// Pipe3 chains three option-returning functions, see [FlatMap].
+func Pipe3[A, B, C, D any](t T[A], f func(A) T[B], g func(B) T[C], h func(C) T[D]) T[D] {
+	return FlatMap(Pipe2(t, f, g), h)
+}
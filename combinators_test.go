@@ -0,0 +1,231 @@
+package opt_test
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleMap() {
+	something := opt.Some(42)
+	nothing := opt.None[int]()
+
+	fmt.Printf("%s %s",
+		opt.Map(something, strconv.Itoa),
+		opt.Map(nothing, strconv.Itoa),
+	)
+	// Output: Some[string](42) None[string]()
+}
+
+func ExampleFlatMap() {
+	parse := func(s string) opt.T[int] {
+		value, err := strconv.Atoi(s)
+		if err != nil {
+			return opt.None[int]()
+		}
+
+		return opt.Some(value)
+	}
+
+	fmt.Printf("%s %s %s",
+		opt.FlatMap(opt.Some("42"), parse),
+		opt.FlatMap(opt.Some("nope"), parse),
+		opt.FlatMap(opt.None[string](), parse),
+	)
+	// Output: Some[int](42) None[int]() None[int]()
+}
+
+func ExampleT_AndThen() {
+	half := func(v int) opt.T[int] {
+		if v%2 != 0 {
+			return opt.None[int]()
+		}
+
+		return opt.Some(v / 2)
+	}
+
+	fmt.Printf("%s %s",
+		opt.Some(8).AndThen(half).AndThen(half),
+		opt.Some(6).AndThen(half).AndThen(half),
+	)
+	// Output: Some[int](2) None[int]()
+}
+
+func ExampleT_Filter() {
+	positive := func(port int) bool { return port > 0 }
+
+	fmt.Printf("%s %s",
+		opt.Some(8080).Filter(positive),
+		opt.Some(-1).Filter(positive),
+	)
+	// Output: Some[int](8080) None[int]()
+}
+
+func ExampleMatch() {
+	greet := func(name opt.String) string {
+		return opt.Match(name,
+			func(name string) string { return "hello " + name },
+			func() string { return "hello stranger" },
+		)
+	}
+
+	fmt.Printf("%s, %s", greet(opt.Some("gopher")), greet(opt.None[string]()))
+	// Output: hello gopher, hello stranger
+}
+
+func ExampleT_Match() {
+	for _, name := range []opt.String{opt.Some("gopher"), opt.None[string]()} {
+		name.Match(
+			func(name string) { fmt.Println("hello", name) },
+			func() { fmt.Println("hello stranger") },
+		)
+	}
+	// Output: hello gopher
+	// hello stranger
+}
+
+func ExampleT_IfPresent() {
+	for _, name := range []opt.String{opt.Some("gopher"), opt.None[string]()} {
+		name.
+			IfPresent(func(name string) { fmt.Println("found", name) }).
+			IfEmpty(func() { fmt.Println("found nothing") })
+	}
+	// Output: found gopher
+	// found nothing
+}
+
+func ExampleFlatten() {
+	fmt.Println(opt.Flatten(opt.Some(opt.Some(42))))
+	fmt.Println(opt.Flatten(opt.Some(opt.None[int]())))
+	fmt.Println(opt.Flatten(opt.None[opt.T[int]]()))
+	// Output: Some[int](42)
+	// None[int]()
+	// None[int]()
+}
+
+func ExampleT_Or() {
+	request := opt.None[string]()
+	cached := opt.Some("cached")
+
+	fmt.Println(request.Or(cached))
+	// Output: Some[string](cached)
+}
+
+func ExampleT_OrFunc() {
+	request := opt.Some("request")
+	lookup := func() opt.String {
+		fmt.Println("never called")
+
+		return opt.Some("lookup")
+	}
+
+	fmt.Println(request.OrFunc(lookup))
+	// Output: Some[string](request)
+}
+
+func ExampleAnd() {
+	password := opt.Some("hunter2")
+
+	fmt.Println(opt.And(opt.Some("admin"), password))
+	fmt.Println(opt.And(opt.None[string](), password))
+	// Output: Some[string](hunter2)
+	// None[string]()
+}
+
+func ExampleXor() {
+	token := opt.Some("token")
+	password := opt.Some("hunter2")
+
+	fmt.Println(opt.Xor(token, opt.None[string]()))
+	fmt.Println(opt.Xor(token, password))
+	// Output: Some[string](token)
+	// None[string]()
+}
+
+func ExampleCoalesce() {
+	flag := opt.None[string]()
+	env := opt.Some("env")
+	config := opt.Some("config")
+
+	fmt.Println(opt.Coalesce(flag, env, config))
+	fmt.Println(opt.Coalesce[string]())
+	// Output: Some[string](env)
+	// None[string]()
+}
+
+func ExampleLift2() {
+	add := opt.Lift2(func(a, b int) int { return a + b })
+
+	fmt.Println(add(opt.Some(1), opt.Some(2)))
+	fmt.Println(add(opt.Some(1), opt.None[int]()))
+	// Output: Some[int](3)
+	// None[int]()
+}
+
+func ExampleLift3() {
+	join := opt.Lift3(func(host string, port int, tls bool) string {
+		return fmt.Sprintf("%s:%d (tls=%v)", host, port, tls)
+	})
+
+	fmt.Println(join(opt.Some("localhost"), opt.Some(443), opt.Some(true)))
+	// Output: Some[string](localhost:443 (tls=true))
+}
+
+func ExampleMapErr() {
+	fmt.Println(opt.MapErr(opt.Some("42"), strconv.Atoi))
+	fmt.Println(opt.MapErr(opt.None[string](), strconv.Atoi))
+	fmt.Println(opt.MapErr(opt.Some("nope"), strconv.Atoi))
+	// Output: Some[int](42) <nil>
+	// None[int]() <nil>
+	// None[int]() strconv.Atoi: parsing "nope": invalid syntax
+}
+
+func ExamplePipe3() {
+	users := map[string]int{"gopher": 1}
+	teams := map[int]string{1: "go"}
+
+	lookupUser := func(name string) opt.T[int] {
+		id, ok := users[name]
+
+		return opt.FromOk(id, ok)
+	}
+	lookupTeam := func(id int) opt.String {
+		team, ok := teams[id]
+
+		return opt.FromOk(team, ok)
+	}
+	shout := func(team string) opt.String {
+		return opt.Some(strings.ToUpper(team))
+	}
+
+	fmt.Println(opt.Pipe3(opt.Some("gopher"), lookupUser, lookupTeam, shout))
+	fmt.Println(opt.Pipe3(opt.Some("nobody"), lookupUser, lookupTeam, shout))
+	// Output: Some[string](GO)
+	// None[string]()
+}
+
+func ExampleT_Inspect() {
+	port := opt.Some(8080).
+		Inspect(func(port int) { fmt.Println("got", port) }).
+		Filter(func(port int) bool { return port < 1024 })
+
+	fmt.Println(port)
+	// Output: got 8080
+	// None[int]()
+}
+
+func TestMapPreservesPresence(t *testing.T) {
+	err := quick.Check(func(input opt.T[int]) bool {
+		output := opt.Map(input, func(v int) int64 { return int64(v) })
+
+		return input.IsPresent() == output.IsPresent() &&
+			int64(input.OrZero()) == output.OrZero()
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
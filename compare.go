@@ -0,0 +1,91 @@
+package opt
+
+import "cmp"
+
+// Contains returns whether the option is present and equal to want.
+func Contains[V comparable](t T[V], want V) bool {
+	value, present := t.Unwrap()
+
+	return present && value == want
+}
+
+// ContainsFunc returns whether the option is present
+// and the wrapped value satisfies pred.
+func ContainsFunc[V any](t T[V], pred func(V) bool) bool {
+	value, present := t.Unwrap()
+
+	return present && pred(value)
+}
+
+// Equal returns whether both options are empty,
+// or both are present and their wrapped values are equal.
+//
+// In contrast to ==, the wrapped values are only compared if both are present.
+func Equal[V comparable](a, b T[V]) bool {
+	return EqualFunc(a, b, func(a, b V) bool { return a == b })
+}
+
+// EqualFunc is like [Equal], but uses eq to compare the wrapped values,
+// which allows comparing options of non-comparable types.
+func EqualFunc[V any](a, b T[V], eq func(V, V) bool) bool {
+	if a.present != b.present {
+		return false
+	}
+
+	return !a.present || eq(a.v, b.v)
+}
+
+// Compare compares two options, ordering empty options before present ones,
+// and present options by their wrapped values, see [cmp.Compare].
+//
+// The result is -1 if a < b, 0 if a == b, and +1 if a > b,
+// thus Compare can be used with [slices.SortFunc].
+func Compare[V cmp.Ordered](a, b T[V]) int {
+	return CompareFunc(a, b, cmp.Compare[V])
+}
+
+// Less reports whether a is less than b, see [Compare].
+func Less[V cmp.Ordered](a, b T[V]) bool {
+	return Compare(a, b) < 0
+}
+
+// CompareFunc is like [Compare], but uses cmp to compare the wrapped values.
+func CompareFunc[V any](a, b T[V], cmp func(V, V) int) int {
+	return NoneFirst(cmp)(a, b)
+}
+
+// NoneFirst returns a comparison function for options,
+// that orders empty options before present ones,
+// and present options according to cmp.
+func NoneFirst[V any](cmp func(V, V) int) func(a, b T[V]) int {
+	return func(a, b T[V]) int {
+		switch {
+		case !a.present && !b.present:
+			return 0
+		case !a.present:
+			return -1
+		case !b.present:
+			return +1
+		default:
+			return cmp(a.v, b.v)
+		}
+	}
+}
+
+// NoneLast returns a comparison function for options,
+// that orders empty options after present ones,
+// and present options according to cmp.
+func NoneLast[V any](cmp func(V, V) int) func(a, b T[V]) int {
+	return func(a, b T[V]) int {
+		switch {
+		case !a.present && !b.present:
+			return 0
+		case !a.present:
+			return +1
+		case !b.present:
+			return -1
+		default:
+			return cmp(a.v, b.v)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package opt_test
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleContains() {
+	fmt.Println(opt.Contains(opt.Some("admin"), "admin"))
+	fmt.Println(opt.Contains(opt.Some("guest"), "admin"))
+	fmt.Println(opt.Contains(opt.None[string](), ""))
+	// Output: true
+	// false
+	// false
+}
+
+func ExampleContainsFunc() {
+	tags := opt.Some([]string{"stable", "latest"})
+
+	fmt.Println(opt.ContainsFunc(tags, func(tags []string) bool {
+		return strings.Contains(strings.Join(tags, ","), "latest")
+	}))
+	// Output: true
+}
+
+func ExampleEqual() {
+	fmt.Println(opt.Equal(opt.Some(1), opt.Some(1)))
+	fmt.Println(opt.Equal(opt.Some(1), opt.None[int]()))
+	fmt.Println(opt.Equal(opt.None[int](), opt.None[int]()))
+	// Output: true
+	// false
+	// true
+}
+
+func ExampleEqualFunc() {
+	a := opt.Some([]int{1, 2, 3})
+	b := opt.Some([]int{1, 2, 3})
+
+	fmt.Println(opt.EqualFunc(a, b, slices.Equal[[]int]))
+	// Output: true
+}
+
+func TestEqualIgnoresStaleValues(t *testing.T) {
+	stale := opt.Some(42)
+	_ = stale.UnmarshalJSON([]byte("null"))
+
+	if !opt.Equal(stale, opt.None[int]()) {
+		t.Fatalf("expected %s to equal %s", stale, opt.None[int]())
+	}
+}
+
+func TestEqualReflexive(t *testing.T) {
+	err := quick.Check(func(input opt.T[string]) bool {
+		return opt.Equal(input, input)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func ExampleCompare() {
+	values := []opt.T[int]{opt.Some(3), opt.None[int](), opt.Some(1)}
+	slices.SortFunc(values, opt.Compare[int])
+
+	fmt.Println(values)
+	// Output: [None[int]() Some[int](1) Some[int](3)]
+}
+
+func ExampleNoneLast() {
+	values := []opt.String{opt.None[string](), opt.Some("b"), opt.Some("a")}
+	slices.SortFunc(values, opt.NoneLast(cmp.Compare[string]))
+
+	fmt.Println(values)
+	// Output: [Some[string](a) Some[string](b) None[string]()]
+}
+
+func TestCompareAntisymmetric(t *testing.T) {
+	err := quick.Check(func(a, b opt.T[int]) bool {
+		return opt.Compare(a, b) == -opt.Compare(b, a) &&
+			opt.Less(a, b) == (opt.Compare(a, b) < 0)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,15 @@
+package opt
+
+// MarshalCSV implements the TypeMarshaller of github.com/gocarina/gocsv,
+// encoding empty options as empty cells, see [T.MarshalText].
+func (t T[V]) MarshalCSV() (string, error) {
+	text, err := t.MarshalText()
+
+	return string(text), err
+}
+
+// UnmarshalCSV implements the TypeUnmarshaller of github.com/gocarina/gocsv,
+// decoding empty cells as empty options, see [T.UnmarshalText].
+func (t *T[V]) UnmarshalCSV(cell string) error {
+	return t.UnmarshalText([]byte(cell))
+}
@@ -0,0 +1,102 @@
+package csv_test
+
+import (
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/gocarina/gocsv"
+
+	"github.com/lukasngl/opt"
+)
+
+type Record struct {
+	Name     opt.T[string]    `csv:"name"`
+	Quantity opt.T[int]       `csv:"quantity"`
+	Price    opt.T[float64]   `csv:"price"`
+	Shipped  opt.T[time.Time] `csv:"shipped"`
+}
+
+const records = `name,quantity,price,shipped
+gopher,2,,2024-01-02T03:04:05Z
+,,9.5,
+`
+
+func TestMarshal(t *testing.T) {
+	data, err := gocsv.MarshalString([]Record{
+		{
+			Name:     opt.Some("gopher"),
+			Quantity: opt.Some(2),
+			Shipped:  opt.Some(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		},
+		{Price: opt.Some(9.5)},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data != records {
+		t.Fatalf("got %q, want %q", data, records)
+	}
+}
+
+func TestUnmarshal(t *testing.T) {
+	var got []Record
+
+	err := gocsv.UnmarshalString(records, &got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(got) != 2 || !opt.Contains(got[0].Name, "gopher") || got[0].Price.IsPresent() ||
+		got[1].Name.IsPresent() || !opt.Contains(got[1].Price, 9.5) || got[1].Shipped.IsPresent() {
+		t.Fatalf("unexpected records %v", got)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	type Thing struct {
+		Int   opt.T[int]     `csv:"int"`
+		Bool  opt.T[bool]    `csv:"bool"`
+		Float opt.T[float64] `csv:"float"`
+	}
+
+	err := quick.Check(func(ints []opt.T[int], b opt.T[bool], f opt.T[float64]) bool {
+		ser := make([]Thing, 0, len(ints))
+		for _, i := range ints {
+			ser = append(ser, Thing{Int: i, Bool: b, Float: f})
+		}
+
+		data, err := gocsv.MarshalString(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		var de []Thing
+
+		err = gocsv.UnmarshalString(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if len(de) != len(ser) {
+			return false
+		}
+
+		for i := range de {
+			if de[i] != ser[i] {
+				t.Logf("ser: %v", ser[i])
+				t.Logf("de: %v", de[i])
+
+				return false
+			}
+		}
+
+		return true
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
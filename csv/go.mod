@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/csv
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/gocarina/gocsv v0.0.0-20260926200228-b2c6eb8fefab
+	github.com/lukasngl/opt v0.0.0
+)
@@ -0,0 +1,42 @@
+package opt_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_MarshalCSV() {
+	for _, value := range []opt.T[float64]{opt.Some(1.5), opt.None[float64]()} {
+		cell, _ := value.MarshalCSV()
+		fmt.Printf("%q\n", cell)
+	}
+	// Output:
+	// "1.5"
+	// ""
+}
+
+func TestCSVIdentity(t *testing.T) {
+	err := quick.Check(func(ser opt.T[int]) bool {
+		var de opt.T[int]
+
+		cell, err := ser.MarshalCSV()
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = de.UnmarshalCSV(cell)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,66 @@
+package opt
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+// hookOption is implemented by pointers to options, used to detect them in [DecodeHook].
+type hookOption interface {
+	decodeHook(data any) error
+}
+
+var hookOptionType = reflect.TypeOf((*hookOption)(nil)).Elem()
+
+// DecodeHook returns a decode hook for github.com/go-viper/mapstructure/v2,
+// and thus viper, decoding raw values into options.
+//
+// Nil is decoded as an empty option, while missing keys leave options untouched.
+// Otherwise values of V, numbers and text, see [T.UnmarshalText], are converted directly,
+// while other values like maps are converted via JSON, i.e. respecting json tags.
+func DecodeHook() func(from, to reflect.Type, data any) (any, error) {
+	return func(_, to reflect.Type, data any) (any, error) {
+		if !reflect.PointerTo(to).Implements(hookOptionType) {
+			return data, nil
+		}
+
+		if data != nil && reflect.TypeOf(data) == to {
+			return data, nil
+		}
+
+		option := reflect.New(to)
+
+		err := option.Interface().(hookOption).decodeHook(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return option.Elem().Interface(), nil
+	}
+}
+
+func (t *T[V]) decodeHook(data any) error {
+	switch data := data.(type) {
+	case nil:
+		*t = None[V]()
+
+		return nil
+	case V:
+		*t = Some(data)
+
+		return nil
+	case string:
+		return t.UnmarshalText([]byte(data))
+	}
+
+	if t.setNumber(data) == nil {
+		return nil
+	}
+
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	return t.UnmarshalJSON(raw)
+}
@@ -0,0 +1,79 @@
+package opt_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleDecodeHook() {
+	hook := opt.DecodeHook()
+	to := reflect.TypeOf(opt.T[int]{})
+
+	for _, data := range []any{8080, "8080", float64(8080), nil} {
+		port, _ := hook(reflect.TypeOf(data), to, data)
+		fmt.Println(port)
+	}
+	// Output:
+	// Some[int](8080)
+	// Some[int](8080)
+	// Some[int](8080)
+	// None[int]()
+}
+
+func TestDecodeHookPassThrough(t *testing.T) {
+	hook := opt.DecodeHook()
+
+	data, err := hook(reflect.TypeOf(""), reflect.TypeOf(0), "42")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data != "42" {
+		t.Fatalf("expected data to be passed through, got %v", data)
+	}
+}
+
+func TestDecodeHookStruct(t *testing.T) {
+	type Server struct {
+		Host string `json:"host"`
+		Port int    `json:"port"`
+	}
+
+	hook := opt.DecodeHook()
+	data := map[string]any{"host": "localhost", "port": 8080}
+
+	server, err := hook(reflect.TypeOf(data), reflect.TypeOf(opt.T[Server]{}), data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Equal(server.(opt.T[Server]), opt.Some(Server{Host: "localhost", Port: 8080})) {
+		t.Fatalf("unexpected server %v", server)
+	}
+
+	_, err = hook(reflect.TypeOf(""), reflect.TypeOf(opt.T[Server]{}), "localhost")
+	if err == nil {
+		t.Fatal("expected error decoding a string into a struct")
+	}
+}
+
+func TestDecodeHookOverflow(t *testing.T) {
+	hook := opt.DecodeHook()
+
+	for _, tc := range []struct {
+		data any
+		to   reflect.Type
+	}{
+		{data: 70000, to: reflect.TypeOf(opt.T[int16]{})},
+		{data: -1, to: reflect.TypeOf(opt.T[uint8]{})},
+		{data: 1.9, to: reflect.TypeOf(opt.T[int]{})},
+	} {
+		value, err := hook(reflect.TypeOf(tc.data), tc.to, tc.data)
+		if err == nil {
+			t.Errorf("expected error decoding %v into %s, got %v", tc.data, tc.to, value)
+		}
+	}
+}
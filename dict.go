@@ -0,0 +1,39 @@
+package opt
+
+// Dict is a map, whose lookups return options.
+//
+// As Dict is a plain map type, it can be created with make or a composite literal,
+// and is encoded like a map, e.g. by [encoding/json].
+type Dict[K comparable, V any] map[K]V
+
+// Get returns an option containing the value for the given key if present,
+// and an empty option otherwise.
+func (d Dict[K, V]) Get(key K) T[V] {
+	value, present := d[key]
+
+	return FromOk(value, present)
+}
+
+// GetOrInsert returns the value for the given key,
+// inserting the given value first if the key is not present.
+//
+// Panics if the dict is nil, like writes to a nil map.
+func (d Dict[K, V]) GetOrInsert(key K, value V) V {
+	existing, present := d[key]
+	if present {
+		return existing
+	}
+
+	d[key] = value
+
+	return value
+}
+
+// Pop removes the given key and returns an option containing its value if present,
+// and an empty option otherwise.
+func (d Dict[K, V]) Pop(key K) T[V] {
+	value := d.Get(key)
+	delete(d, key)
+
+	return value
+}
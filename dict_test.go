@@ -0,0 +1,27 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleDict() {
+	ports := opt.Dict[string, int]{"http": 80}
+
+	fmt.Println(ports.Get("http"))
+	fmt.Println(ports.Get("https"))
+	fmt.Println(ports.GetOrInsert("https", 443))
+	fmt.Println(ports.Pop("http"))
+	fmt.Println(ports.Pop("http"))
+
+	data, _ := json.Marshal(ports)
+	fmt.Println(string(data))
+	// Output: Some[int](80)
+	// None[int]()
+	// 443
+	// Some[int](80)
+	// None[int]()
+	// {"https":443}
+}
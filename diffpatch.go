@@ -0,0 +1,116 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Operation is an operation of a JSON patch (RFC 6902).
+type Operation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value,omitempty"`
+}
+
+// DiffPatch returns the JSON patch (RFC 6902) transforming before into after,
+// which must be structs of the same type, e.g. for audit logs.
+//
+// Options becoming present are added, options becoming empty are removed,
+// and changed values are replaced, where fields like patch.Field, that have an
+// "IsDefined() bool" method, are considered absent if undefined.
+// Structs, that do not implement [json.Marshaler], are compared recursively,
+// even if wrapped in an option, while other values are compared by their JSON.
+// Field names are taken from the json tag, see [MergePatch].
+func DiffPatch(before, after any) ([]Operation, error) {
+	rb := reflect.Indirect(reflect.ValueOf(before))
+	ra := reflect.Indirect(reflect.ValueOf(after))
+
+	if rb.Kind() != reflect.Struct || rb.Type() != ra.Type() {
+		return nil, fmt.Errorf("opt: cannot diff %T and %T", before, after)
+	}
+
+	return diffPatch(nil, "", addressable(rb), addressable(ra))
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func diffPatch(ops []Operation, path string, before, after reflect.Value) ([]Operation, error) {
+	for i := 0; i < before.NumField(); i++ {
+		name, _, ok := jsonField(before.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		var err error
+
+		ops, err = diffPatchField(ops, path+"/"+pointerEscaper.Replace(name), before.Field(i), after.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("opt: field %s: %w", name, err)
+		}
+	}
+
+	return ops, nil
+}
+
+func diffPatchField(ops []Operation, path string, before, after reflect.Value) ([]Operation, error) {
+	before, beforePresent, err := diffPatchValue(before)
+	if err != nil {
+		return nil, err
+	}
+
+	after, afterPresent, err := diffPatchValue(after)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case !beforePresent && !afterPresent:
+		return ops, nil
+	case !afterPresent:
+		return append(ops, Operation{Op: "remove", Path: path}), nil //nolint:exhaustruct
+	case beforePresent && isPatchable(before) && isPatchable(after):
+		return diffPatch(ops, path, before, after)
+	}
+
+	data, err := json.Marshal(after.Addr().Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	if !beforePresent {
+		return append(ops, Operation{Op: "add", Path: path, Value: data}), nil
+	}
+
+	previous, err := json.Marshal(before.Addr().Interface())
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Equal(previous, data) {
+		return ops, nil
+	}
+
+	return append(ops, Operation{Op: "replace", Path: path, Value: data}), nil
+}
+
+// diffPatchValue returns the value of the field to compare and whether it is present.
+func diffPatchValue(field reflect.Value) (reflect.Value, bool, error) {
+	if field.Type().Implements(definedFieldType) {
+		return field, field.Interface().(definedField).IsDefined(), nil
+	}
+
+	option, ok := field.Addr().Interface().(Optional)
+	if !ok {
+		return field, true, nil
+	}
+
+	value, present := optionalValue(option)
+	if !present {
+		return field, false, nil
+	}
+
+	return value, true, nil
+}
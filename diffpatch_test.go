@@ -0,0 +1,80 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/patch"
+)
+
+func ExampleDiffPatch() {
+	before := UserPatch{
+		Name:     opt.Some("gopher"),
+		Nickname: patch.Value("gophy"),
+		Age:      opt.Some(13),
+		Address:  opt.Some(AddressPatch{City: opt.Some("Berlin")}),
+	}
+	after := UserPatch{
+		Name:     opt.Some("gopher"),
+		Nickname: patch.Null[string](),
+		Bio:      patch.Value("Likes go/generics"),
+		Address:  opt.Some(AddressPatch{City: opt.Some("Hamburg"), Zip: opt.Some("20095")}),
+		Version:  2,
+	}
+
+	ops, _ := opt.DiffPatch(before, after)
+
+	for _, op := range ops {
+		data, _ := json.Marshal(op)
+		fmt.Println(string(data))
+	}
+	// Output:
+	// {"op":"replace","path":"/nickname","value":null}
+	// {"op":"add","path":"/bio","value":"Likes go/generics"}
+	// {"op":"remove","path":"/age"}
+	// {"op":"replace","path":"/address/city","value":"Hamburg"}
+	// {"op":"add","path":"/address/zip","value":"20095"}
+	// {"op":"replace","path":"/version","value":2}
+}
+
+func TestDiffPatchInvalid(t *testing.T) {
+	_, err := opt.DiffPatch(UserPatch{}, AddressPatch{})
+	if err == nil {
+		t.Fatal("expected error diffing different types")
+	}
+}
+
+func TestDiffPatchEscape(t *testing.T) {
+	type Thing struct {
+		Path opt.T[string] `json:"a/b~c"`
+	}
+
+	ops, err := opt.DiffPatch(Thing{}, Thing{Path: opt.Some("x")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ops) != 1 || ops[0].Path != "/a~1b~0c" {
+		t.Fatalf("unexpected operations %v", ops)
+	}
+}
+
+func TestDiffPatchIdentity(t *testing.T) {
+	err := quick.Check(func(name, city opt.T[string], age opt.T[int]) bool {
+		user := UserPatch{Name: name, Age: age, Address: opt.Some(AddressPatch{City: city})}
+
+		ops, err := opt.DiffPatch(user, &user)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return len(ops) == 0
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,166 @@
+// Package either provides a type representing one of two possible values,
+// covering cases where [opt.T] is not expressive enough.
+package either
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lukasngl/opt"
+)
+
+// T represents either a left value of type L or a right value of type R.
+//
+// The zero value contains the zero value of L as left value.
+type T[L, R any] struct {
+	left    L
+	right   R
+	isRight bool
+}
+
+// Left creates a new either containing the given left value.
+func Left[L, R any](value L) T[L, R] {
+	//nolint:exhaustruct
+	return T[L, R]{left: value}
+}
+
+// Right creates a new either containing the given right value.
+func Right[L, R any](value R) T[L, R] {
+	//nolint:exhaustruct
+	return T[L, R]{right: value, isRight: true}
+}
+
+// FromOpt creates a new either from an option,
+// containing the wrapped value as right value if present,
+// and the given left value otherwise.
+func FromOpt[L, R any](t opt.T[R], left L) T[L, R] {
+	value, present := t.Unwrap()
+	if !present {
+		return Left[L, R](left)
+	}
+
+	return Right[L](value)
+}
+
+// IsLeft returns whether the either contains a left value.
+func (t T[L, R]) IsLeft() bool {
+	return !t.isRight
+}
+
+// IsRight returns whether the either contains a right value.
+func (t T[L, R]) IsRight() bool {
+	return t.isRight
+}
+
+// Left returns an option containing the left value if present,
+// and an empty option otherwise.
+func (t T[L, R]) Left() opt.T[L] {
+	return opt.SomeIf(!t.isRight, t.left)
+}
+
+// Right returns an option containing the right value if present,
+// and an empty option otherwise.
+func (t T[L, R]) Right() opt.T[R] {
+	return opt.SomeIf(t.isRight, t.right)
+}
+
+// Swap returns a new either with left and right exchanged.
+func (t T[L, R]) Swap() T[R, L] {
+	return T[R, L]{
+		left:    t.right,
+		right:   t.left,
+		isRight: !t.isRight,
+	}
+}
+
+// String implements [fmt.Stringer].
+func (t T[L, R]) String() string {
+	if t.isRight {
+		return fmt.Sprintf("Right[%T](%v)", t.right, t.right)
+	}
+
+	return fmt.Sprintf("Left[%T](%v)", t.left, t.left)
+}
+
+// Fold returns the result of onLeft applied to the left value if present,
+// and the result of onRight applied to the right value otherwise.
+func Fold[L, R, Z any](t T[L, R], onLeft func(L) Z, onRight func(R) Z) Z {
+	if t.isRight {
+		return onRight(t.right)
+	}
+
+	return onLeft(t.left)
+}
+
+// JSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &T[any, any]{}
+	_ json.Marshaler   = T[any, any]{}
+)
+
+// ErrInvalidJSON is returned when unmarshalling a JSON object,
+// that does not contain exactly one of the "left" and "right" keys.
+var ErrInvalidJSON = errors.New("either: expected exactly one of \"left\" or \"right\"")
+
+type jsonT struct {
+	Left  json.RawMessage `json:"left,omitempty"`
+	Right json.RawMessage `json:"right,omitempty"`
+}
+
+// MarshalJSON implements [json.Marshaler].
+//
+// The either is encoded as an object with a single "left" or "right" key.
+func (t T[L, R]) MarshalJSON() ([]byte, error) {
+	var (
+		encoded jsonT
+		err     error
+	)
+
+	if t.isRight {
+		encoded.Right, err = json.Marshal(t.right)
+	} else {
+		encoded.Left, err = json.Marshal(t.left)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(encoded)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (t *T[L, R]) UnmarshalJSON(data []byte) error {
+	var encoded jsonT
+
+	err := json.Unmarshal(data, &encoded)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case encoded.Left != nil && encoded.Right == nil:
+		var left L
+
+		err = json.Unmarshal(encoded.Left, &left)
+		if err != nil {
+			return err
+		}
+
+		*t = Left[L, R](left)
+	case encoded.Left == nil && encoded.Right != nil:
+		var right R
+
+		err = json.Unmarshal(encoded.Right, &right)
+		if err != nil {
+			return err
+		}
+
+		*t = Right[L](right)
+	default:
+		return ErrInvalidJSON
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package either_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/either"
+)
+
+func ExampleFold() {
+	describe := func(t either.T[int, string]) string {
+		return either.Fold(t, strconv.Itoa, func(s string) string { return strconv.Quote(s) })
+	}
+
+	fmt.Println(describe(either.Left[int, string](42)))
+	fmt.Println(describe(either.Right[int]("answer")))
+	// Output: 42
+	// "answer"
+}
+
+func ExampleT_Swap() {
+	fmt.Println(either.Left[int, string](42).Swap())
+	// Output: Right[int](42)
+}
+
+func ExampleFromOpt() {
+	fmt.Println(either.FromOpt(opt.Some("value"), 404))
+	fmt.Println(either.FromOpt(opt.None[string](), 404))
+	// Output: Right[string](value)
+	// Left[int](404)
+}
+
+func ExampleT_MarshalJSON() {
+	data, _ := json.Marshal([]either.T[int, string]{
+		either.Left[int, string](42),
+		either.Right[int]("answer"),
+	})
+
+	fmt.Println(string(data))
+	// Output: [{"left":42},{"right":"answer"}]
+}
+
+func TestUnmarshalJSONInvalid(t *testing.T) {
+	for _, input := range []string{`{}`, `{"left":1,"right":"a"}`} {
+		var value either.T[int, string]
+
+		err := json.Unmarshal([]byte(input), &value)
+		if !errors.Is(err, either.ErrInvalidJSON) {
+			t.Errorf("unmarshal %s: expected %v, got %v", input, either.ErrInvalidJSON, err)
+		}
+	}
+}
+
+func TestMarshalIdentity(t *testing.T) {
+	err := quick.Check(func(isRight bool, left int, right string) bool {
+		ser := either.Left[int, string](left)
+		if isRight {
+			ser = either.Right[int](right)
+		}
+
+		var de either.T[int, string]
+
+		data, err := json.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = json.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
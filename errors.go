@@ -0,0 +1,37 @@
+package opt
+
+import "errors"
+
+// IsErr returns whether the option is present
+// and the wrapped error matches target, see [errors.Is].
+func IsErr(t T[error], target error) bool {
+	return ContainsFunc(t, func(err error) bool {
+		return errors.Is(err, target)
+	})
+}
+
+// AsErr returns an option containing the first error in the wrapped error's tree,
+// that matches E, see [errors.As],
+// and an empty option if there is none or the option is empty.
+func AsErr[E error](t T[error]) T[E] {
+	return FlatMap(t, func(err error) T[E] {
+		var target E
+
+		return FromOk(target, errors.As(err, &target))
+	})
+}
+
+// JoinErr returns an option containing the wrapped errors of all present options
+// joined into one, see [errors.Join],
+// and an empty option if there are no non-nil errors.
+func JoinErr(opts ...T[error]) T[error] {
+	return FromNillable(nillable(errors.Join(Values(opts)...)))
+}
+
+func nillable(err error) *error {
+	if err == nil {
+		return nil
+	}
+
+	return &err
+}
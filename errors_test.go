@@ -0,0 +1,38 @@
+package opt_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleIsErr() {
+	_, err := os.Open("does-not-exist")
+	failure := opt.Some(err)
+
+	fmt.Println(opt.IsErr(failure, fs.ErrNotExist))
+	fmt.Println(opt.IsErr(opt.None[error](), fs.ErrNotExist))
+	// Output: true
+	// false
+}
+
+func ExampleAsErr() {
+	_, err := os.Open("does-not-exist")
+
+	pathErr := opt.AsErr[*fs.PathError](opt.Some(err))
+	fmt.Println(opt.Map(pathErr, func(err *fs.PathError) string { return err.Op }))
+	// Output: Some[string](open)
+}
+
+func ExampleJoinErr() {
+	joined := opt.JoinErr(opt.Some(errors.New("first")), opt.None[error](), opt.Some(errors.New("second")))
+	fmt.Println(joined.Must())
+
+	fmt.Println(opt.JoinErr(opt.None[error](), opt.Some[error](nil)).IsPresent())
+	// Output: first
+	// second
+	// false
+}
@@ -0,0 +1,87 @@
+package opt
+
+import (
+	"sync"
+	"time"
+)
+
+// Expiring is an option whose value reads as empty once its time to live has passed.
+//
+// The zero value is an empty option using [time.Now] as clock.
+// An Expiring is safe for concurrent use and must not be copied after first use.
+type Expiring[V any] struct {
+	mu        sync.Mutex
+	now       func() time.Time
+	t         T[V]
+	expiresAt time.Time
+}
+
+// NewExpiring creates a new empty expiring option,
+// that uses the given clock to determine expiry.
+//
+// If now is nil, [time.Now] is used.
+func NewExpiring[V any](now func() time.Time) *Expiring[V] {
+	//nolint:exhaustruct
+	return &Expiring[V]{now: now}
+}
+
+// Set wraps the given value, which expires after the given time to live.
+func (e *Expiring[V]) Set(value V, ttl time.Duration) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.t.Set(value)
+	e.expiresAt = e.clock().Add(ttl)
+}
+
+// Get returns the option, which is empty if no value was set,
+// or the value has expired.
+func (e *Expiring[V]) Get() T[V] {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.expire()
+
+	return e.t
+}
+
+// Refresh extends the time to live of the wrapped value,
+// and returns whether the value was present.
+func (e *Expiring[V]) Refresh(ttl time.Duration) bool {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.expire()
+
+	if !e.t.present {
+		return false
+	}
+
+	e.expiresAt = e.clock().Add(ttl)
+
+	return true
+}
+
+// Clear empties the option, dropping the wrapped value.
+func (e *Expiring[V]) Clear() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.t.Clear()
+}
+
+// expire drops the wrapped value if it has expired.
+// The caller must hold the lock.
+func (e *Expiring[V]) expire() {
+	if e.t.present && !e.clock().Before(e.expiresAt) {
+		e.t.Clear()
+	}
+}
+
+func (e *Expiring[V]) clock() time.Time {
+	if e.now == nil {
+		return time.Now()
+	}
+
+	return e.now()
+}
@@ -0,0 +1,32 @@
+package opt_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleExpiring() {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return now }
+
+	token := opt.NewExpiring[string](clock)
+	token.Set("secret", time.Minute)
+	fmt.Println(token.Get())
+
+	now = now.Add(30 * time.Second)
+	fmt.Println(token.Refresh(time.Minute))
+
+	now = now.Add(45 * time.Second)
+	fmt.Println(token.Get())
+
+	now = now.Add(15 * time.Second)
+	fmt.Println(token.Get())
+	fmt.Println(token.Refresh(time.Minute))
+	// Output: Some[string](secret)
+	// true
+	// Some[string](secret)
+	// None[string]()
+	// false
+}
@@ -0,0 +1,76 @@
+package opt
+
+import (
+	"context"
+	"sync"
+)
+
+// Future is an option that is resolved asynchronously, e.g. by a goroutine,
+// for lookups that may legitimately find nothing.
+//
+// The zero value is an unresolved future.
+// A Future is safe for concurrent use and must not be copied after first use.
+type Future[V any] struct {
+	init sync.Once
+	once sync.Once
+	done chan struct{}
+	t    T[V]
+}
+
+// NewFuture creates a new unresolved future.
+func NewFuture[V any]() *Future[V] {
+	return new(Future[V])
+}
+
+// channel returns the done channel, creating it on first use.
+func (f *Future[V]) channel() chan struct{} {
+	f.init.Do(func() {
+		f.done = make(chan struct{})
+	})
+
+	return f.done
+}
+
+// Resolve resolves the future with the given option,
+// and returns whether the future was resolved by this call.
+//
+// Only the first call resolves the future, subsequent calls have no effect.
+func (f *Future[V]) Resolve(t T[V]) bool {
+	resolved := false
+
+	f.once.Do(func() {
+		f.t = t
+		resolved = true
+
+		close(f.channel())
+	})
+
+	return resolved
+}
+
+// Done returns a channel that is closed once the future is resolved.
+func (f *Future[V]) Done() <-chan struct{} {
+	return f.channel()
+}
+
+// Await blocks until the future is resolved and returns the option,
+// or until the context is done and returns the context's error.
+func (f *Future[V]) Await(ctx context.Context) (T[V], error) {
+	select {
+	case <-f.channel():
+		return f.t, nil
+	case <-ctx.Done():
+		return None[V](), ctx.Err()
+	}
+}
+
+// TryGet returns the option and true if the future is resolved,
+// and an empty option and false otherwise, without blocking.
+func (f *Future[V]) TryGet() (T[V], bool) {
+	select {
+	case <-f.channel():
+		return f.t, true
+	default:
+		return None[V](), false
+	}
+}
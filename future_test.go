@@ -0,0 +1,57 @@
+package opt_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleFuture() {
+	user := opt.NewFuture[string]()
+
+	fmt.Println(user.TryGet())
+
+	go user.Resolve(opt.None[string]())
+
+	fmt.Println(user.Await(context.Background()))
+	fmt.Println(user.Resolve(opt.Some("too late")))
+	// Output: None[string]() false
+	// None[string]() <nil>
+	// false
+}
+
+func TestFutureAwaitCanceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+
+	_, err := opt.NewFuture[string]().Await(ctx)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected %v, got %v", context.DeadlineExceeded, err)
+	}
+}
+
+func TestFutureZeroValue(t *testing.T) {
+	var user opt.Future[string]
+
+	if _, resolved := user.TryGet(); resolved {
+		t.Fatal("expected zero value to be unresolved")
+	}
+
+	go user.Resolve(opt.Some("gopher"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	value, err := user.Await(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Contains(value, "gopher") {
+		t.Fatalf("expected Some(gopher), got %s", value)
+	}
+}
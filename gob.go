@@ -0,0 +1,43 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// Gob Encoding und Decoding.
+var (
+	_ gob.GobEncoder = T[any]{}
+	_ gob.GobDecoder = &T[any]{}
+)
+
+// GobEncode implements [gob.GobEncoder].
+//
+// The encoding consists of a presence byte,
+// followed by the value encoded using [encoding/gob] if present.
+//
+// In contrast to [T.MarshalBinary], the value is always encoded using gob,
+// which honors the value's own gob and binary encoding methods.
+func (t T[V]) GobEncode() ([]byte, error) {
+	return t.encodeTagged(gobEncode[V])
+}
+
+// GobDecode implements [gob.GobDecoder].
+func (t *T[V]) GobDecode(data []byte) error {
+	return t.decodeTagged(data, gobDecode[V])
+}
+
+func gobEncode[V any](value V) ([]byte, error) {
+	var buffer bytes.Buffer
+
+	err := gob.NewEncoder(&buffer).Encode(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func gobDecode[V any](data []byte, value *V) error {
+	return gob.NewDecoder(bytes.NewReader(data)).Decode(value)
+}
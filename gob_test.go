@@ -0,0 +1,65 @@
+package opt_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+type Session struct {
+	User  opt.String
+	Admin opt.Bool
+	Tags  opt.T[[]string]
+}
+
+func TestGobRoundTrip(t *testing.T) {
+	var (
+		buffer bytes.Buffer
+		de     Session
+	)
+
+	ser := Session{User: opt.Some("gopher"), Admin: opt.None[bool](), Tags: opt.Some([]string{"a"})}
+
+	err := gob.NewEncoder(&buffer).Encode(ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = gob.NewDecoder(&buffer).Decode(&de)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if de.User != ser.User || de.Admin != ser.Admin || de.Tags.String() != ser.Tags.String() {
+		t.Fatalf("expected %+v, got %+v", ser, de)
+	}
+}
+
+func TestGobIdentity(t *testing.T) {
+	err := quick.Check(func(ser Thing) bool {
+		var (
+			buffer bytes.Buffer
+			de     Thing
+		)
+
+		err := gob.NewEncoder(&buffer).Encode(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = gob.NewDecoder(&buffer).Decode(&de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
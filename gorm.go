@@ -0,0 +1,63 @@
+package opt
+
+import (
+	"reflect"
+	"time"
+)
+
+// gormDataTyper is the GormDataTypeInterface of gorm.io/gorm/schema.
+type gormDataTyper interface {
+	GormDataType() string
+}
+
+// GORM Data Type.
+var _ gormDataTyper = T[any]{}
+
+// GormDataType implements the GormDataTypeInterface of gorm.io/gorm/schema,
+// thus GORM infers the column type of V, instead of inspecting the fields of the option:
+//
+//  1. If the value implements GormDataTypeInterface, it is used,
+//     even if implemented by *V,
+//  2. otherwise the generic data type of its kind is used, like "int" for integers,
+//     "time" for [time.Time] and "bytes" for byte slices,
+//     defaulting to "string", e.g. for values stored using a serializer.
+//
+// As GORM creates nullable columns unless tagged "not null",
+// empty options are stored as NULL, see [T.Value].
+func (t T[V]) GormDataType() string {
+	var value V
+
+	if typer, ok := any(&value).(gormDataTyper); ok {
+		return typer.GormDataType()
+	}
+
+	return gormDataType(reflect.TypeFor[V]())
+}
+
+func gormDataType(rt reflect.Type) string {
+	for rt.Kind() == reflect.Pointer {
+		rt = rt.Elem()
+	}
+
+	switch rt.Kind() {
+	case reflect.Bool:
+		return "bool"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int"
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "uint"
+	case reflect.Float32, reflect.Float64:
+		return "float"
+	case reflect.Struct:
+		if rt.ConvertibleTo(reflect.TypeFor[time.Time]()) {
+			return "time"
+		}
+	case reflect.Slice, reflect.Array:
+		if rt.Elem().Kind() == reflect.Uint8 {
+			return "bytes"
+		}
+	default:
+	}
+
+	return "string"
+}
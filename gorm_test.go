@@ -0,0 +1,44 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+// Decimal implements GormDataTypeInterface only on its pointer.
+type Decimal struct {
+	units int64
+}
+
+func (*Decimal) GormDataType() string {
+	return "decimal"
+}
+
+func ExampleT_GormDataType() {
+	fmt.Println(opt.Some(42).GormDataType())
+	fmt.Println(opt.None[time.Time]().GormDataType())
+	fmt.Println(opt.None[Decimal]().GormDataType())
+	// Output: int
+	// time
+	// decimal
+}
+
+func TestGormDataType(t *testing.T) {
+	for want, dataTyper := range map[string]interface{ GormDataType() string }{
+		"bool":   opt.None[bool](),
+		"int":    opt.None[int8](),
+		"uint":   opt.None[uint64](),
+		"float":  opt.None[float32](),
+		"string": opt.None[[]string](),
+		"time":   opt.None[*time.Time](),
+		"bytes":  opt.None[json.RawMessage](),
+	} {
+		if got := dataTyper.GormDataType(); got != want {
+			t.Errorf("expected %s for %T, got %s", want, dataTyper, got)
+		}
+	}
+}
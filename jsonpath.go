@@ -0,0 +1,121 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// FromJSONPath returns the raw JSON value at the given path in data,
+// or an empty option if the path does not exist or data is invalid.
+//
+// The path consists of object keys and array indices separated by dots,
+// e.g. "users.0.name", where dots in keys are escaped by a backslash.
+// An empty path refers to data itself. Explicit nulls are present, i.e. Some("null").
+func FromJSONPath(data []byte, path string) T[json.RawMessage] {
+	raw := json.RawMessage(data)
+
+	if path == "" {
+		if !json.Valid(raw) {
+			return None[json.RawMessage]()
+		}
+
+		return Some(raw)
+	}
+
+	for _, segment := range splitJSONPath(path) {
+		next, ok := jsonPathSegment(raw, segment)
+		if !ok {
+			return None[json.RawMessage]()
+		}
+
+		raw = next
+	}
+
+	return Some(raw)
+}
+
+// FromJSONPathAs is like [FromJSONPath], but unmarshals the value into V.
+//
+// An empty option is returned, if the path does not exist, the value is null,
+// or it cannot be unmarshaled into V.
+func FromJSONPathAs[V any](data []byte, path string) T[V] {
+	return FlatMap(FromJSONPath(data, path), func(raw json.RawMessage) T[V] {
+		var value T[V]
+
+		err := json.Unmarshal(raw, &value)
+		if err != nil {
+			return None[V]()
+		}
+
+		return value
+	})
+}
+
+func jsonPathSegment(raw json.RawMessage, segment string) (json.RawMessage, bool) {
+	switch firstByte(raw) {
+	case '{':
+		var object map[string]json.RawMessage
+
+		err := json.Unmarshal(raw, &object)
+		if err != nil {
+			return nil, false
+		}
+
+		value, ok := object[segment]
+
+		return value, ok
+	case '[':
+		index, err := strconv.Atoi(segment)
+		if err != nil || index < 0 {
+			return nil, false
+		}
+
+		var array []json.RawMessage
+
+		err = json.Unmarshal(raw, &array)
+		if err != nil || index >= len(array) {
+			return nil, false
+		}
+
+		return array[index], true
+	default:
+		return nil, false
+	}
+}
+
+func firstByte(raw json.RawMessage) byte {
+	raw = bytes.TrimLeft(raw, " \t\r\n")
+	if len(raw) == 0 {
+		return 0
+	}
+
+	return raw[0]
+}
+
+func splitJSONPath(path string) []string {
+	var (
+		segments []string
+		segment  strings.Builder
+		escaped  bool
+	)
+
+	for _, r := range path {
+		switch {
+		case escaped:
+			segment.WriteRune(r)
+
+			escaped = false
+		case r == '\\':
+			escaped = true
+		case r == '.':
+			segments = append(segments, segment.String())
+			segment.Reset()
+		default:
+			segment.WriteRune(r)
+		}
+	}
+
+	return append(segments, segment.String())
+}
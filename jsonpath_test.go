@@ -0,0 +1,79 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+const order = `{
+	"id": "o-1",
+	"customer": {"name": "Gopher", "email": null},
+	"items": [{"sku": "A1", "quantity": 2}, {"sku": "B2", "quantity": 1}],
+	"meta": {"version.major": 3}
+}`
+
+func ExampleFromJSONPath() {
+	name, _ := opt.FromJSONPath([]byte(order), "customer.name").Unwrap()
+	email, _ := opt.FromJSONPath([]byte(order), "customer.email").Unwrap()
+
+	fmt.Println(string(name), string(email))
+	fmt.Println(opt.FromJSONPath([]byte(order), "customer.phone").IsPresent())
+	// Output: "Gopher" null
+	// false
+}
+
+func ExampleFromJSONPathAs() {
+	fmt.Println(opt.FromJSONPathAs[int]([]byte(order), "items.1.quantity"))
+	fmt.Println(opt.FromJSONPathAs[int]([]byte(order), "items.2.quantity"))
+	fmt.Println(opt.FromJSONPathAs[string]([]byte(order), "customer.email"))
+	fmt.Println(opt.FromJSONPathAs[int]([]byte(order), `meta.version\.major`))
+	// Output: Some[int](1)
+	// None[int]()
+	// None[string]()
+	// Some[int](3)
+}
+
+func TestFromJSONPath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		data string
+		path string
+		want opt.T[string]
+	}{
+		{data: order, path: "", want: opt.Some(order)},
+		{data: order, path: "id", want: opt.Some(`"o-1"`)},
+		{data: order, path: "items.0", want: opt.Some(`{"sku": "A1", "quantity": 2}`)},
+		{data: order, path: "items.-1", want: opt.None[string]()},
+		{data: order, path: "items.first", want: opt.None[string]()},
+		{data: order, path: "id.length", want: opt.None[string]()},
+		{data: order, path: "customer.", want: opt.None[string]()},
+		{data: `{"": 1}`, path: ".", want: opt.None[string]()},
+		{data: `{"": {"": 1}}`, path: ".", want: opt.Some("1")},
+		{data: `{"a": `, path: "", want: opt.None[string]()},
+		{data: `{"a": `, path: "a", want: opt.None[string]()},
+		{data: ` [1, 2]`, path: "1", want: opt.Some("2")},
+	}
+
+	for _, c := range cases {
+		got := opt.Map(opt.FromJSONPath([]byte(c.data), c.path), func(raw json.RawMessage) string {
+			return string(raw)
+		})
+
+		if got != c.want {
+			t.Errorf("FromJSONPath(%q, %q) = %v, want %v", c.data, c.path, got, c.want)
+		}
+	}
+}
+
+func TestFromJSONPathAsMismatch(t *testing.T) {
+	t.Parallel()
+
+	got := opt.FromJSONPathAs[int]([]byte(order), "customer.name")
+	if got.IsPresent() {
+		t.Errorf("expected mismatching type to be empty, got %v", got)
+	}
+}
@@ -0,0 +1,107 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package opt
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+)
+
+// JSON v2 Marshalling und Unmarshalling.
+var (
+	_ jsonv2.UnmarshalerFrom = &T[any]{}
+	_ jsonv2.MarshalerTo     = T[any]{}
+)
+
+// MarshalJSONTo implements [jsonv2.MarshalerTo].
+//
+// The value is encoded by the given encoder, thus respecting its options,
+// through a pointer like [T.MarshalJSON].
+func (t T[V]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	if !t.present {
+		return enc.WriteToken(jsontext.Null)
+	}
+
+	if bytes, ok := any(t.v).([]byte); ok && bytes == nil {
+		return enc.WriteToken(jsontext.String(""))
+	}
+
+	return jsonv2.MarshalEncode(enc, &t.v)
+}
+
+// UnmarshalJSONFrom implements [jsonv2.UnmarshalerFrom],
+// decoding into a fresh V like [T.UnmarshalJSON].
+func (t *T[V]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	if dec.PeekKind() == 'n' {
+		t.present = false
+
+		_, err := dec.ReadToken()
+
+		return err
+	}
+
+	var value V
+
+	err := jsonv2.UnmarshalDecode(dec, &value)
+	if err != nil {
+		return err
+	}
+
+	t.v, t.present = value, true
+
+	return nil
+}
+
+// ProtoJSON v2 Marshalling und Unmarshalling,
+// which takes precedence over the methods promoted from [T].
+var (
+	_ jsonv2.UnmarshalerFrom = &ProtoJSON[any]{}
+	_ jsonv2.MarshalerTo     = ProtoJSON[any]{}
+)
+
+// MarshalJSONTo implements [jsonv2.MarshalerTo], see [ProtoJSON.MarshalJSON].
+func (p ProtoJSON[V]) MarshalJSONTo(enc *jsontext.Encoder) error {
+	data, err := p.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return enc.WriteValue(data)
+}
+
+// UnmarshalJSONFrom implements [jsonv2.UnmarshalerFrom], see [ProtoJSON.UnmarshalJSON].
+func (p *ProtoJSON[V]) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+
+	return p.UnmarshalJSON(data)
+}
+
+// RawBytes v2 Marshalling und Unmarshalling,
+// which takes precedence over the methods promoted from [T].
+var (
+	_ jsonv2.UnmarshalerFrom = &RawBytes{}
+	_ jsonv2.MarshalerTo     = RawBytes{}
+)
+
+// MarshalJSONTo implements [jsonv2.MarshalerTo], see [RawBytes.MarshalJSON].
+func (r RawBytes) MarshalJSONTo(enc *jsontext.Encoder) error {
+	data, err := r.MarshalJSON()
+	if err != nil {
+		return err
+	}
+
+	return enc.WriteValue(data)
+}
+
+// UnmarshalJSONFrom implements [jsonv2.UnmarshalerFrom], see [RawBytes.UnmarshalJSON].
+func (r *RawBytes) UnmarshalJSONFrom(dec *jsontext.Decoder) error {
+	data, err := dec.ReadValue()
+	if err != nil {
+		return err
+	}
+
+	return r.UnmarshalJSON(data)
+}
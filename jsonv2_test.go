@@ -0,0 +1,88 @@
+//go:build goexperiment.jsonv2 && go1.27
+
+package opt_test
+
+import (
+	"encoding/json/jsontext"
+	jsonv2 "encoding/json/v2"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_MarshalJSONTo() {
+	type Config struct {
+		Name    opt.String      `json:"name"`
+		Timeout opt.T[int]      `json:"timeout,omitzero"`
+		Tags    opt.T[[]string] `json:"tags"`
+	}
+
+	data, _ := jsonv2.Marshal(Config{
+		Name: opt.Some("gopher"),
+		Tags: opt.Some([]string(nil)),
+	}, jsonv2.FormatNilSliceAsNull(true))
+
+	fmt.Println(string(data))
+	// Output: {"name":"gopher","tags":null}
+}
+
+func ExampleT_UnmarshalJSONFrom() {
+	type Config struct {
+		Name    opt.String `json:"name"`
+		Timeout opt.T[int] `json:"timeout"`
+	}
+
+	var config Config
+
+	_ = jsonv2.Unmarshal([]byte(`{"name":"gopher","timeout":null}`), &config)
+
+	fmt.Println(config.Name, config.Timeout)
+	// Output: Some[string](gopher) None[int]()
+}
+
+func TestJSONv2Stream(t *testing.T) {
+	dec := jsontext.NewDecoder(strings.NewReader(`1 null 3`))
+
+	var got []opt.T[int]
+
+	for dec.PeekKind() != 0 {
+		var value opt.T[int]
+
+		err := jsonv2.UnmarshalDecode(dec, &value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		got = append(got, value)
+	}
+
+	if fmt.Sprint(got) != "[Some[int](1) None[int]() Some[int](3)]" {
+		t.Fatalf("unexpected options %v", got)
+	}
+}
+
+func TestJSONv2Identity(t *testing.T) {
+	err := quick.Check(func(ser opt.T[map[string]int]) bool {
+		var de opt.T[map[string]int]
+
+		data, err := jsonv2.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = jsonv2.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return fmt.Sprint(de) == fmt.Sprint(ser)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
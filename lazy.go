@@ -0,0 +1,66 @@
+package opt
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Defer returns a function that lazily creates an option from fn.
+//
+// fn is called at most once, on the first call of the returned function,
+// whose result is returned on all subsequent calls.
+// The returned function is safe for concurrent use.
+func Defer[V any](fn func() (V, bool)) func() T[V] {
+	return NewLazy(fn).Get
+}
+
+// Lazy is an option that is computed on first access and memoized afterwards.
+//
+// A Lazy is safe for concurrent use and must not be copied after first use.
+type Lazy[V any] struct {
+	once     sync.Once
+	fn       func() (V, bool)
+	result   T[V]
+	panicked any
+	resolved atomic.Bool
+}
+
+// NewLazy creates a new lazy option, that is computed by calling fn
+// on the first call of [Lazy.Get].
+func NewLazy[V any](fn func() (V, bool)) *Lazy[V] {
+	//nolint:exhaustruct
+	return &Lazy[V]{fn: fn}
+}
+
+// Get returns the option, calling the underlying function if it is not resolved yet.
+//
+// Concurrent callers block until the first call has completed.
+// If the function panics, Get panics with the same value on this and all subsequent calls,
+// while the option remains unresolved.
+func (l *Lazy[V]) Get() T[V] {
+	l.once.Do(func() {
+		completed := false
+
+		defer func() {
+			if !completed {
+				l.panicked = recover()
+			}
+		}()
+
+		l.result = FromOk(l.fn())
+		l.fn = nil
+		completed = true
+		l.resolved.Store(true)
+	})
+
+	if l.panicked != nil {
+		panic(l.panicked)
+	}
+
+	return l.result
+}
+
+// IsResolved returns whether the option has already been computed.
+func (l *Lazy[V]) IsResolved() bool {
+	return l.resolved.Load()
+}
@@ -0,0 +1,94 @@
+package opt_test
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleDefer() {
+	home := opt.Defer(func() (string, bool) {
+		fmt.Println("looking up")
+
+		return os.LookupEnv("OPT_EXAMPLE_UNSET")
+	})
+
+	fmt.Println(home())
+	fmt.Println(home())
+	// Output: looking up
+	// None[string]()
+	// None[string]()
+}
+
+func ExampleLazy() {
+	templates := opt.NewLazy(func() (string, bool) {
+		fmt.Println("parsing templates")
+
+		return "parsed", true
+	})
+
+	fmt.Println(templates.IsResolved())
+	fmt.Println(templates.Get())
+	fmt.Println(templates.Get(), templates.IsResolved())
+	// Output: false
+	// parsing templates
+	// Some[string](parsed)
+	// Some[string](parsed) true
+}
+
+func TestLazyConcurrent(t *testing.T) {
+	var calls atomic.Int32
+
+	lazy := opt.NewLazy(func() (int, bool) {
+		calls.Add(1)
+
+		return 42, true
+	})
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if value := lazy.Get(); !opt.Contains(value, 42) {
+				t.Errorf("expected Some(42), got %s", value)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected exactly one call, got %d", calls.Load())
+	}
+}
+
+func TestLazyPanic(t *testing.T) {
+	lazy := opt.NewLazy(func() (int, bool) {
+		panic("boom")
+	})
+
+	for i := 0; i < 2; i++ {
+		func() {
+			defer func() {
+				if r := recover(); r != "boom" {
+					t.Errorf("call %d: expected panic boom, got %v", i, r)
+				}
+			}()
+
+			value := lazy.Get()
+			t.Errorf("call %d: expected panic, got %s", i, value)
+		}()
+	}
+
+	if lazy.IsResolved() {
+		t.Fatal("expected a panicking lazy option to remain unresolved")
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/mapstructure
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/go-viper/mapstructure/v2 v2.5.0
+	github.com/lukasngl/opt v0.0.0
+)
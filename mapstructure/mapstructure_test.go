@@ -0,0 +1,83 @@
+package mapstructure_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-viper/mapstructure/v2"
+
+	"github.com/lukasngl/opt"
+)
+
+type Server struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type Config struct {
+	Name    opt.T[string]    `mapstructure:"name"`
+	Port    opt.T[int]       `mapstructure:"port"`
+	Debug   opt.T[bool]      `mapstructure:"debug"`
+	Timeout opt.T[float64]   `mapstructure:"timeout"`
+	Started opt.T[time.Time] `mapstructure:"started"`
+	Proxy   opt.T[Server]    `mapstructure:"proxy"`
+	Tags    opt.T[[]string]  `mapstructure:"tags"`
+}
+
+func decode(t *testing.T, input map[string]any) Config {
+	t.Helper()
+
+	var config Config
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: opt.DecodeHook(),
+		Result:     &config,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = decoder.Decode(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return config
+}
+
+func TestDecodeHook(t *testing.T) {
+	config := decode(t, map[string]any{
+		"name":    "gopher",
+		"port":    "8080",
+		"debug":   nil,
+		"timeout": 1,
+		"started": "2024-01-02T03:04:05Z",
+		"proxy":   map[string]any{"host": "localhost", "port": 3128},
+		"tags":    []any{"a", "b"},
+	})
+
+	switch {
+	case !opt.Contains(config.Name, "gopher"):
+		t.Errorf("unexpected name %s", config.Name)
+	case !opt.Contains(config.Port, 8080):
+		t.Errorf("unexpected port %s", config.Port)
+	case config.Debug.IsPresent():
+		t.Errorf("unexpected debug %s", config.Debug)
+	case !opt.Contains(config.Timeout, 1):
+		t.Errorf("unexpected timeout %s", config.Timeout)
+	case !opt.Contains(config.Started, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)):
+		t.Errorf("unexpected started %s", config.Started)
+	case !opt.Equal(config.Proxy, opt.Some(Server{Host: "localhost", Port: 3128})):
+		t.Errorf("unexpected proxy %s", config.Proxy)
+	case config.Tags.String() != "Some[[]string]([a b])":
+		t.Errorf("unexpected tags %s", config.Tags)
+	}
+}
+
+func TestDecodeHookMissing(t *testing.T) {
+	config := decode(t, map[string]any{})
+
+	if config.Name.IsPresent() || config.Port.IsPresent() || config.Proxy.IsPresent() || config.Tags.IsPresent() {
+		t.Errorf("expected missing keys to be empty, got %v", config)
+	}
+}
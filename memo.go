@@ -0,0 +1,152 @@
+package opt
+
+import (
+	"sync"
+	"time"
+)
+
+// Memo resolves keys to options through a function,
+// deduplicating concurrent lookups of the same key,
+// and caching present and empty results with separate time to lives.
+//
+// A Memo is safe for concurrent use and must not be copied after first use.
+type Memo[K comparable, V any] struct {
+	fn         func(K) T[V]
+	presentTTL time.Duration
+	emptyTTL   time.Duration
+
+	mu      sync.Mutex
+	entries map[K]*memoEntry[V]
+	sweepAt time.Time
+}
+
+type memoEntry[V any] struct {
+	done      chan struct{}
+	resolved  bool
+	t         T[V]
+	expiresAt time.Time
+}
+
+// NewMemo creates a new memo, that resolves keys by calling fn.
+//
+// Present results are cached for presentTTL and empty results for emptyTTL,
+// a non-positive time to live disables caching of the respective results,
+// while concurrent lookups are still deduplicated.
+func NewMemo[K comparable, V any](fn func(K) T[V], presentTTL, emptyTTL time.Duration) *Memo[K, V] {
+	//nolint:exhaustruct
+	return &Memo[K, V]{
+		fn:         fn,
+		presentTTL: presentTTL,
+		emptyTTL:   emptyTTL,
+		entries:    map[K]*memoEntry[V]{},
+	}
+}
+
+// Get returns the option for the given key,
+// either from the cache, by waiting for a concurrent lookup of the same key,
+// or by calling the underlying function.
+//
+// If the underlying function panics, the panic is propagated to the caller,
+// concurrent callers waiting for the result receive an empty option,
+// and the next lookup calls the underlying function again.
+//
+// Expired results of other keys are evicted periodically, at most once per time to live.
+func (m *Memo[K, V]) Get(key K) T[V] {
+	m.mu.Lock()
+	m.sweep()
+
+	entry, present := m.entries[key]
+	if present && entry.resolved && !time.Now().Before(entry.expiresAt) {
+		delete(m.entries, key)
+
+		present = false
+	}
+
+	if present {
+		m.mu.Unlock()
+		<-entry.done
+
+		return entry.t
+	}
+
+	//nolint:exhaustruct
+	entry = &memoEntry[V]{done: make(chan struct{})}
+	m.entries[key] = entry
+	m.mu.Unlock()
+
+	// track completion instead of recovering, to not cache the result of a panicking lookup.
+	completed := false
+	result := None[V]()
+
+	defer func() {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+
+		ttl := m.emptyTTL
+		if result.present {
+			ttl = m.presentTTL
+		}
+
+		entry.t = result
+		entry.resolved = true
+		entry.expiresAt = time.Now().Add(ttl)
+
+		if (!completed || ttl <= 0) && m.entries[key] == entry {
+			delete(m.entries, key)
+		}
+
+		close(entry.done)
+	}()
+
+	result = m.fn(key)
+	completed = true
+
+	return result
+}
+
+// sweep removes all expired results, if the larger time to live has passed since the last sweep,
+// such that results of keys, that are not requested again, do not accumulate.
+func (m *Memo[K, V]) sweep() {
+	interval := max(m.presentTTL, m.emptyTTL)
+
+	now := time.Now()
+	if interval <= 0 || now.Before(m.sweepAt) {
+		return
+	}
+
+	for key, entry := range m.entries {
+		if entry.resolved && !now.Before(entry.expiresAt) {
+			delete(m.entries, key)
+		}
+	}
+
+	m.sweepAt = now.Add(interval)
+}
+
+// Len returns the number of cached results, including expired ones not evicted yet.
+func (m *Memo[K, V]) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	count := 0
+
+	for _, entry := range m.entries {
+		if entry.resolved {
+			count++
+		}
+	}
+
+	return count
+}
+
+// Forget removes the cached result for the given key,
+// such that the next lookup calls the underlying function again.
+func (m *Memo[K, V]) Forget(key K) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry, present := m.entries[key]
+	if present && entry.resolved {
+		delete(m.entries, key)
+	}
+}
@@ -0,0 +1,155 @@
+package opt_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleMemo() {
+	users := map[int]string{1: "gopher"}
+
+	memo := opt.NewMemo(func(id int) opt.String {
+		fmt.Println("looking up", id)
+
+		name, ok := users[id]
+
+		return opt.FromOk(name, ok)
+	}, time.Hour, time.Hour)
+
+	fmt.Println(memo.Get(1))
+	fmt.Println(memo.Get(1))
+	fmt.Println(memo.Get(2))
+	fmt.Println(memo.Get(2))
+	// Output: looking up 1
+	// Some[string](gopher)
+	// Some[string](gopher)
+	// looking up 2
+	// None[string]()
+	// None[string]()
+}
+
+func TestMemoDeduplicatesConcurrentLookups(t *testing.T) {
+	var (
+		calls   atomic.Int32
+		release = make(chan struct{})
+		wg      sync.WaitGroup
+	)
+
+	memo := opt.NewMemo(func(key string) opt.T[int] {
+		calls.Add(1)
+		<-release
+
+		return opt.Some(len(key))
+	}, 0, 0)
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if value := memo.Get("gopher"); !opt.Contains(value, 6) {
+				t.Errorf("expected Some(6), got %s", value)
+			}
+		}()
+	}
+
+	// give the goroutines a chance to join the pending lookup.
+	time.Sleep(10 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	if calls.Load() != 1 {
+		t.Fatalf("expected exactly one call, got %d", calls.Load())
+	}
+}
+
+func TestMemoNegativeCaching(t *testing.T) {
+	var calls atomic.Int32
+
+	memo := opt.NewMemo(func(key string) opt.T[int] {
+		calls.Add(1)
+
+		return opt.None[int]()
+	}, time.Hour, 0)
+
+	memo.Get("missing")
+	memo.Get("missing")
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected empty results not to be cached, got %d calls", calls.Load())
+	}
+}
+
+func TestMemoForget(t *testing.T) {
+	var calls atomic.Int32
+
+	memo := opt.NewMemo(func(key string) opt.T[int] {
+		calls.Add(1)
+
+		return opt.Some(1)
+	}, time.Hour, time.Hour)
+
+	memo.Get("key")
+	memo.Forget("key")
+	memo.Get("key")
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected forgotten result to be looked up again, got %d calls", calls.Load())
+	}
+}
+
+func TestMemoSweep(t *testing.T) {
+	memo := opt.NewMemo(func(key string) opt.T[int] {
+		return opt.Some(len(key))
+	}, 10*time.Millisecond, 0)
+
+	memo.Get("a")
+	memo.Get("b")
+
+	if memo.Len() != 2 {
+		t.Fatalf("expected 2 cached results, got %d", memo.Len())
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	memo.Get("c")
+
+	if memo.Len() != 1 {
+		t.Fatalf("expected expired results to be evicted, got %d cached results", memo.Len())
+	}
+}
+
+func TestMemoPanic(t *testing.T) {
+	var calls atomic.Int32
+
+	memo := opt.NewMemo(func(key string) opt.T[int] {
+		if calls.Add(1) == 1 {
+			panic("transient")
+		}
+
+		return opt.Some(1)
+	}, time.Hour, time.Hour)
+
+	func() {
+		defer func() {
+			if recover() != "transient" {
+				t.Error("expected the panic to be propagated")
+			}
+		}()
+
+		memo.Get("key")
+	}()
+
+	if value := memo.Get("key"); !opt.Contains(value, 1) {
+		t.Fatalf("expected the panic not to be cached, got %s", value)
+	}
+
+	if calls.Load() != 2 {
+		t.Fatalf("expected the lookup to be retried, got %d calls", calls.Load())
+	}
+}
@@ -0,0 +1,161 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// definedField is implemented by fields distinguishing undefined and null,
+// like patch.Field.
+type definedField interface {
+	IsDefined() bool
+}
+
+var (
+	definedFieldType = reflect.TypeOf((*definedField)(nil)).Elem()
+	marshalerType    = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+)
+
+// MergePatch returns a JSON merge patch (RFC 7386) of the struct v,
+// e.g. as the body of a PATCH request.
+//
+// Empty options are omitted, while fields like patch.Field, that have an
+// "IsDefined() bool" method, are omitted if undefined, thus allowing explicit nulls.
+// Structs, that do not implement [json.Marshaler], are patched recursively,
+// even if wrapped in an option, while other values are marshaled as JSON.
+// Field names and omitempty are taken from the json tag.
+func MergePatch(v any) ([]byte, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("opt: cannot create merge patch of %T", v)
+	}
+
+	return mergePatch(addressable(rv))
+}
+
+func mergePatch(rv reflect.Value) ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteByte('{')
+
+	for i := 0; i < rv.NumField(); i++ {
+		name, omitEmpty, ok := jsonField(rv.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		data, present, err := mergePatchField(rv.Field(i), omitEmpty)
+		if err != nil {
+			return nil, fmt.Errorf("opt: field %s: %w", name, err)
+		}
+
+		if !present {
+			continue
+		}
+
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(data)
+	}
+
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+func mergePatchField(field reflect.Value, omitEmpty bool) ([]byte, bool, error) {
+	if field.Type().Implements(definedFieldType) {
+		if !field.Interface().(definedField).IsDefined() {
+			return nil, false, nil
+		}
+
+		data, err := json.Marshal(field.Addr().Interface())
+
+		return data, true, err
+	}
+
+	if option, ok := field.Addr().Interface().(Optional); ok {
+		value, present := optionalValue(option)
+		if !present {
+			return nil, false, nil
+		}
+
+		if isPatchable(value) {
+			data, err := mergePatch(value)
+
+			return data, true, err
+		}
+	} else if omitEmpty && isEmptyValue(field) {
+		return nil, false, nil
+	} else if isPatchable(field) {
+		data, err := mergePatch(field)
+
+		return data, true, err
+	}
+
+	data, err := json.Marshal(field.Addr().Interface())
+
+	return data, true, err
+}
+
+// isPatchable reports, whether the value is patched recursively.
+func isPatchable(value reflect.Value) bool {
+	return value.Kind() == reflect.Struct &&
+		!value.Type().Implements(marshalerType) && !reflect.PointerTo(value.Type()).Implements(marshalerType)
+}
+
+// isEmptyValue reports, whether the value is omitted by omitempty, like encoding/json does.
+func isEmptyValue(value reflect.Value) bool {
+	switch value.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return value.Len() == 0
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64,
+		reflect.Interface, reflect.Pointer:
+		return value.IsZero()
+	default:
+		return false
+	}
+}
+
+func jsonField(field reflect.StructField) (string, bool, bool) {
+	if !field.IsExported() {
+		return "", false, false
+	}
+
+	name, options, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "-" && options == "" {
+		return "", false, false
+	}
+
+	if name == "" {
+		name = field.Name
+	}
+
+	return name, strings.Contains(","+options+",", ",omitempty,"), true
+}
+
+func addressable(rv reflect.Value) reflect.Value {
+	if rv.CanAddr() {
+		return rv
+	}
+
+	result := reflect.New(rv.Type()).Elem()
+	result.Set(rv)
+
+	return result
+}
@@ -0,0 +1,82 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/patch"
+)
+
+type UserPatch struct {
+	Name     opt.T[string]       `json:"name"`
+	Nickname patch.Field[string] `json:"nickname"`
+	Bio      patch.Field[string] `json:"bio"`
+	Age      opt.T[int]          `json:"age"`
+	Address  opt.T[AddressPatch] `json:"address"`
+	Birthday opt.T[time.Time]    `json:"birthday"`
+	Version  int                 `json:"version,omitempty"`
+	Internal string              `json:"-"`
+}
+
+type AddressPatch struct {
+	City opt.T[string] `json:"city"`
+	Zip  opt.T[string] `json:"zip"`
+}
+
+func ExampleMergePatch() {
+	data, _ := opt.MergePatch(UserPatch{
+		Name:     opt.Some("gopher"),
+		Nickname: patch.Null[string](),
+		Address:  opt.Some(AddressPatch{City: opt.Some("Berlin")}),
+		Birthday: opt.Some(time.Date(2009, 11, 10, 0, 0, 0, 0, time.UTC)),
+		Internal: "secret",
+	})
+
+	fmt.Println(string(data))
+	// Output: {"name":"gopher","nickname":null,"address":{"city":"Berlin"},"birthday":"2009-11-10T00:00:00Z"}
+}
+
+func TestMergePatchInvalid(t *testing.T) {
+	_, err := opt.MergePatch(42)
+	if err == nil {
+		t.Fatal("expected error creating a merge patch of an int")
+	}
+}
+
+// TestMergePatchApply verifies, that applying the patch to an empty document,
+// i.e. decoding it, reproduces the present fields.
+func TestMergePatchApply(t *testing.T) {
+	err := quick.Check(func(name, city opt.T[string], age opt.T[int], version int) bool {
+		var de UserPatch
+
+		ser := UserPatch{Name: name, Age: age, Address: opt.Some(AddressPatch{City: city}), Version: version}
+
+		data, err := opt.MergePatch(&ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = json.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+			t.Log(string(data))
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
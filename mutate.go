@@ -0,0 +1,80 @@
+package opt
+
+// Take returns the wrapped value and whether it is present,
+// leaving an empty option in its place.
+func (t *T[V]) Take() (V, bool) {
+	value, present := t.Unwrap()
+	*t = None[V]()
+
+	return value, present
+}
+
+// Replace wraps the given value, returning the previous option.
+func (t *T[V]) Replace(value V) T[V] {
+	previous := *t
+	*t = Some(value)
+
+	return previous
+}
+
+// GetOrInsert returns the wrapped value,
+// wrapping the given value first if the option is empty.
+func (t *T[V]) GetOrInsert(value V) V {
+	if !t.present {
+		*t = Some(value)
+	}
+
+	return t.v
+}
+
+// GetOrInsertWith returns the wrapped value,
+// wrapping the result of fn first if the option is empty.
+//
+// In contrast to [T.GetOrInsert], the value is only computed when needed.
+func (t *T[V]) GetOrInsertWith(fn func() V) V {
+	if !t.present {
+		*t = Some(fn())
+	}
+
+	return t.v
+}
+
+// Set wraps the given value, replacing the previous option.
+func (t *T[V]) Set(value V) {
+	*t = Some(value)
+}
+
+// Clear empties the option, dropping the wrapped value.
+func (t *T[V]) Clear() {
+	*t = None[V]()
+}
+
+// AsRef returns an option containing a pointer to the wrapped value if present,
+// and an empty option otherwise.
+//
+// In contrast to [T.ToNillable], the pointer references the value inside the option,
+// thus writes through the pointer modify the option.
+//
+// AsRef is a function rather than a method of [T],
+// since a method of T[V] cannot refer to T[*V].
+func AsRef[V any](t *T[V]) T[*V] {
+	if !t.present {
+		return None[*V]()
+	}
+
+	return Some(&t.v)
+}
+
+// MapInPlace replaces the wrapped value with the result of fn if present.
+func (t *T[V]) MapInPlace(fn func(V) V) {
+	if t.present {
+		t.v = fn(t.v)
+	}
+}
+
+// Swap exchanges the contents of two options.
+//
+// Swap is not safe for concurrent use.
+func Swap[V any](a, b *T[V]) {
+	*a, *b = *b, *a
+}
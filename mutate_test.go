@@ -0,0 +1,105 @@
+package opt_test
+
+import (
+	"fmt"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_Take() {
+	cached := opt.Some("token")
+
+	first, firstPresent := cached.Take()
+	second, secondPresent := cached.Take()
+
+	fmt.Printf("%q %v, %q %v, %s", first, firstPresent, second, secondPresent, cached)
+	// Output: "token" true, "" false, None[string]()
+}
+
+func ExampleT_Replace() {
+	cached := opt.None[string]()
+
+	fmt.Println(cached.Replace("first"))
+	fmt.Println(cached.Replace("second"))
+	fmt.Println(cached)
+	// Output: None[string]()
+	// Some[string](first)
+	// Some[string](second)
+}
+
+func ExampleT_GetOrInsert() {
+	var port opt.T[int]
+
+	fmt.Println(port.GetOrInsert(8080))
+	fmt.Println(port.GetOrInsert(9090))
+	fmt.Println(port)
+	// Output: 8080
+	// 8080
+	// Some[int](8080)
+}
+
+func ExampleT_GetOrInsertWith() {
+	var cache opt.T[int]
+
+	load := func() int {
+		fmt.Println("loading")
+
+		return 42
+	}
+
+	fmt.Println(cache.GetOrInsertWith(load))
+	fmt.Println(cache.GetOrInsertWith(load))
+	// Output: loading
+	// 42
+	// 42
+}
+
+func ExampleT_Set() {
+	var config struct {
+		Name opt.String
+	}
+
+	config.Name.Set("gopher")
+	fmt.Println(config.Name)
+
+	config.Name.Clear()
+	fmt.Println(config.Name)
+	// Output: Some[string](gopher)
+	// None[string]()
+}
+
+func ExampleAsRef() {
+	type Config struct {
+		Hosts []string
+	}
+
+	config := opt.Some(Config{Hosts: []string{"a"}})
+
+	opt.AsRef(&config).IfPresent(func(config *Config) {
+		config.Hosts = append(config.Hosts, "b")
+	})
+
+	fmt.Println(config)
+	// Output: Some[opt_test.Config]({[a b]})
+}
+
+func ExampleT_MapInPlace() {
+	counter := opt.Some(41)
+	counter.MapInPlace(func(v int) int { return v + 1 })
+
+	nothing := opt.None[int]()
+	nothing.MapInPlace(func(v int) int { return v + 1 })
+
+	fmt.Println(counter, nothing)
+	// Output: Some[int](42) None[int]()
+}
+
+func ExampleSwap() {
+	front := opt.Some("next")
+	back := opt.None[string]()
+
+	opt.Swap(&front, &back)
+
+	fmt.Println(front, back)
+	// Output: None[string]() Some[string](next)
+}
@@ -0,0 +1,215 @@
+// Package null provides nullable types built on [opt.T],
+// which follow the constructor and method conventions of
+// github.com/guregu/null, to allow migrating incrementally.
+//
+// In contrast to guregu/null, the types embed [opt.T] instead of the sql.Null types,
+// thus the Valid field is replaced by the IsPresent method,
+// and the wrapped value is accessed via ValueOrZero or the methods of [opt.T].
+package null
+
+import (
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+// String is a nullable string.
+type String struct {
+	opt.T[string]
+}
+
+// NewString creates a new String, which is null if valid is false.
+func NewString(s string, valid bool) String {
+	return String{opt.SomeIf(valid, s)}
+}
+
+// StringFrom creates a new String, that is always valid.
+func StringFrom(s string) String {
+	return String{opt.Some(s)}
+}
+
+// StringFromPtr creates a new String, which is null if the pointer is nil.
+func StringFromPtr(s *string) String {
+	return String{opt.FromNillable(s)}
+}
+
+// ValueOrZero returns the wrapped value if valid, and the zero value otherwise.
+func (s String) ValueOrZero() string {
+	return s.OrZero()
+}
+
+// Ptr returns a pointer to the wrapped value if valid, and nil otherwise.
+func (s String) Ptr() *string {
+	return s.ToNillable()
+}
+
+// SetValid changes the value and marks it as valid.
+func (s *String) SetValid(value string) {
+	s.Set(value)
+}
+
+// Equal returns whether both are null, or both are valid and have the same value.
+func (s String) Equal(other String) bool {
+	return opt.Equal(s.T, other.T)
+}
+
+// Int is a nullable int64.
+type Int struct {
+	opt.T[int64]
+}
+
+// NewInt creates a new Int, which is null if valid is false.
+func NewInt(i int64, valid bool) Int {
+	return Int{opt.SomeIf(valid, i)}
+}
+
+// IntFrom creates a new Int, that is always valid.
+func IntFrom(i int64) Int {
+	return Int{opt.Some(i)}
+}
+
+// IntFromPtr creates a new Int, which is null if the pointer is nil.
+func IntFromPtr(i *int64) Int {
+	return Int{opt.FromNillable(i)}
+}
+
+// ValueOrZero returns the wrapped value if valid, and the zero value otherwise.
+func (i Int) ValueOrZero() int64 {
+	return i.OrZero()
+}
+
+// Ptr returns a pointer to the wrapped value if valid, and nil otherwise.
+func (i Int) Ptr() *int64 {
+	return i.ToNillable()
+}
+
+// SetValid changes the value and marks it as valid.
+func (i *Int) SetValid(value int64) {
+	i.Set(value)
+}
+
+// Equal returns whether both are null, or both are valid and have the same value.
+func (i Int) Equal(other Int) bool {
+	return opt.Equal(i.T, other.T)
+}
+
+// Float is a nullable float64.
+type Float struct {
+	opt.T[float64]
+}
+
+// NewFloat creates a new Float, which is null if valid is false.
+func NewFloat(f float64, valid bool) Float {
+	return Float{opt.SomeIf(valid, f)}
+}
+
+// FloatFrom creates a new Float, that is always valid.
+func FloatFrom(f float64) Float {
+	return Float{opt.Some(f)}
+}
+
+// FloatFromPtr creates a new Float, which is null if the pointer is nil.
+func FloatFromPtr(f *float64) Float {
+	return Float{opt.FromNillable(f)}
+}
+
+// ValueOrZero returns the wrapped value if valid, and the zero value otherwise.
+func (f Float) ValueOrZero() float64 {
+	return f.OrZero()
+}
+
+// Ptr returns a pointer to the wrapped value if valid, and nil otherwise.
+func (f Float) Ptr() *float64 {
+	return f.ToNillable()
+}
+
+// SetValid changes the value and marks it as valid.
+func (f *Float) SetValid(value float64) {
+	f.Set(value)
+}
+
+// Equal returns whether both are null, or both are valid and have the same value.
+func (f Float) Equal(other Float) bool {
+	return opt.Equal(f.T, other.T)
+}
+
+// Bool is a nullable bool.
+type Bool struct {
+	opt.T[bool]
+}
+
+// NewBool creates a new Bool, which is null if valid is false.
+func NewBool(b bool, valid bool) Bool {
+	return Bool{opt.SomeIf(valid, b)}
+}
+
+// BoolFrom creates a new Bool, that is always valid.
+func BoolFrom(b bool) Bool {
+	return Bool{opt.Some(b)}
+}
+
+// BoolFromPtr creates a new Bool, which is null if the pointer is nil.
+func BoolFromPtr(b *bool) Bool {
+	return Bool{opt.FromNillable(b)}
+}
+
+// ValueOrZero returns the wrapped value if valid, and the zero value otherwise.
+func (b Bool) ValueOrZero() bool {
+	return b.OrZero()
+}
+
+// Ptr returns a pointer to the wrapped value if valid, and nil otherwise.
+func (b Bool) Ptr() *bool {
+	return b.ToNillable()
+}
+
+// SetValid changes the value and marks it as valid.
+func (b *Bool) SetValid(value bool) {
+	b.Set(value)
+}
+
+// Equal returns whether both are null, or both are valid and have the same value.
+func (b Bool) Equal(other Bool) bool {
+	return opt.Equal(b.T, other.T)
+}
+
+// Time is a nullable time.Time.
+type Time struct {
+	opt.T[time.Time]
+}
+
+// NewTime creates a new Time, which is null if valid is false.
+func NewTime(t time.Time, valid bool) Time {
+	return Time{opt.SomeIf(valid, t)}
+}
+
+// TimeFrom creates a new Time, that is always valid.
+func TimeFrom(t time.Time) Time {
+	return Time{opt.Some(t)}
+}
+
+// TimeFromPtr creates a new Time, which is null if the pointer is nil.
+func TimeFromPtr(t *time.Time) Time {
+	return Time{opt.FromNillable(t)}
+}
+
+// ValueOrZero returns the wrapped value if valid, and the zero value otherwise.
+func (t Time) ValueOrZero() time.Time {
+	return t.OrZero()
+}
+
+// Ptr returns a pointer to the wrapped value if valid, and nil otherwise.
+func (t Time) Ptr() *time.Time {
+	return t.ToNillable()
+}
+
+// SetValid changes the value and marks it as valid.
+func (t *Time) SetValid(value time.Time) {
+	t.Set(value)
+}
+
+// Equal returns whether both are null, or both are valid and represent the same instant,
+// see [time.Time.Equal].
+func (t Time) Equal(other Time) bool {
+	return opt.EqualFunc(t.T, other.T, time.Time.Equal)
+}
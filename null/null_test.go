@@ -0,0 +1,84 @@
+package null_test
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lukasngl/opt/null"
+)
+
+func ExampleNewString() {
+	fmt.Println(null.NewString("gopher", true))
+	fmt.Println(null.NewString("gopher", false))
+	fmt.Println(null.StringFromPtr(nil).ValueOrZero() == "")
+	// Output: Some[string](gopher)
+	// None[string]()
+	// true
+}
+
+func ExampleInt_MarshalJSON() {
+	data, _ := json.Marshal(struct {
+		Count null.Int   `json:"count"`
+		Total null.Int   `json:"total"`
+		Ratio null.Float `json:"ratio"`
+	}{
+		Count: null.IntFrom(42),
+		Total: null.Int{},
+		Ratio: null.FloatFrom(0.5),
+	})
+
+	fmt.Println(string(data))
+	// Output: {"count":42,"total":null,"ratio":0.5}
+}
+
+func TestUnmarshalJSON(t *testing.T) {
+	var value struct {
+		Present null.Bool `json:"present"`
+		Null    null.Bool `json:"null"`
+		Absent  null.Bool `json:"absent"`
+	}
+
+	err := json.Unmarshal([]byte(`{"present":true,"null":null}`), &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !value.Present.Equal(null.BoolFrom(true)) {
+		t.Errorf("expected present to be true, got %s", value.Present)
+	}
+
+	if value.Null.IsPresent() || value.Absent.IsPresent() {
+		t.Errorf("expected null and absent to be null, got %s and %s", value.Null, value.Absent)
+	}
+}
+
+func TestScan(t *testing.T) {
+	var value null.String
+
+	err := value.Scan("gopher")
+	if err != nil || !value.Equal(null.StringFrom("gopher")) {
+		t.Errorf("expected gopher, got %s, %v", value, err)
+	}
+
+	err = value.Scan(nil)
+	if err != nil || value.IsPresent() {
+		t.Errorf("expected null, got %s, %v", value, err)
+	}
+
+	var _ sql.Scanner = &value
+}
+
+func TestTimeEqual(t *testing.T) {
+	instant := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if !null.TimeFrom(instant).Equal(null.TimeFrom(instant.In(time.FixedZone("XTC", 42)))) {
+		t.Error("expected the same instant in different zones to be equal")
+	}
+
+	if null.TimeFrom(instant).Equal(null.Time{}) {
+		t.Error("expected valid and null time to differ")
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"testing/quick"
 
 	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/patch"
 )
 
 func TestOmitZero_(t *testing.T) {
@@ -67,3 +68,71 @@ var typedTests = []func(*testing.T, *rand.Rand) bool{
 		Test2 int
 	}],
 }
+
+func TestOmitZeroPatchField(t *testing.T) {
+	for field, want := range map[patch.Field[string]]string{
+		patch.Undefined[string](): `{}`,
+		patch.Null[string]():      `{"value":null}`,
+		patch.Value("gopher"):     `{"value":"gopher"}`,
+	} {
+		data, err := json.Marshal(struct {
+			Value patch.Field[string] `json:"value,omitzero"`
+		}{field})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != want {
+			t.Errorf("%s marshaled to %s, want %s", field, data, want)
+		}
+	}
+}
+
+func TestOmitZeroProtoJSON(t *testing.T) {
+	for value, want := range map[opt.T[int64]]string{
+		opt.None[int64]():  `{}`,
+		opt.Some[int64](0): `{"value":"0"}`,
+		opt.Some[int64](7): `{"value":"7"}`,
+	} {
+		data, err := json.Marshal(struct {
+			Value opt.ProtoJSON[int64] `json:"value,omitzero"`
+		}{opt.ProtoJSON[int64]{T: value}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != want {
+			t.Errorf("%s marshaled to %s, want %s", value, data, want)
+		}
+	}
+}
+
+func TestOmitZeroRaw(t *testing.T) {
+	type Upstream struct {
+		Nickname opt.Raw[string]  `json:"nickname,omitzero"`
+		Balance  opt.Raw[float64] `json:"balance,omitzero"`
+	}
+
+	for _, input := range []string{
+		`{}`,
+		`{"nickname":null}`,
+		`{"balance":1.50}`,
+		`{"nickname":"gopher","balance":12345678901234567890}`,
+	} {
+		var upstream Upstream
+
+		err := json.Unmarshal([]byte(input), &upstream)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		data, err := json.Marshal(upstream)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != input {
+			t.Errorf("%s marshaled to %s", input, data)
+		}
+	}
+}
@@ -0,0 +1,22 @@
+package opt
+
+import "sync/atomic"
+
+// Once is an option that can be set exactly once, e.g. for configuration singletons.
+//
+// The zero value is an empty option.
+// A Once is safe for concurrent use and must not be copied after first use.
+type Once[V any] struct {
+	p atomic.Pointer[V]
+}
+
+// Set wraps the given value if the option is still empty,
+// and returns whether the value was set.
+func (o *Once[V]) Set(value V) bool {
+	return o.p.CompareAndSwap(nil, &value)
+}
+
+// Get returns the option, which is present once a value was set.
+func (o *Once[V]) Get() T[V] {
+	return FromNillable(o.p.Load())
+}
@@ -0,0 +1,49 @@
+package opt_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleOnce() {
+	var endpoint opt.Once[string]
+
+	fmt.Println(endpoint.Get())
+	fmt.Println(endpoint.Set("https://a.example"))
+	fmt.Println(endpoint.Set("https://b.example"))
+	fmt.Println(endpoint.Get())
+	// Output: None[string]()
+	// true
+	// false
+	// Some[string](https://a.example)
+}
+
+func TestOnceSetConcurrent(t *testing.T) {
+	var (
+		once opt.Once[int]
+		sets atomic.Int32
+		wg   sync.WaitGroup
+	)
+
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			if once.Set(i) {
+				sets.Add(1)
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if sets.Load() != 1 || once.Get().IsEmpty() {
+		t.Fatalf("expected exactly one successful set, got %d", sets.Load())
+	}
+}
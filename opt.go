@@ -70,6 +70,68 @@ func Some[V any](value V) T[V] {
 	}
 }
 
+// FromOk creates a new option from a value and whether it is present,
+// as returned by map lookups, type assertions and channel receives.
+//
+// Inverse of [T.Unwrap].
+func FromOk[V any](value V, ok bool) T[V] {
+	if !ok {
+		return None[V]()
+	}
+
+	return Some(value)
+}
+
+// FromErr creates a new option from a value and an error,
+// discarding the error.
+//
+// If the error is non-nil an empty option is returned,
+// otherwise a present option containing the value is returned.
+func FromErr[V any](value V, err error) T[V] {
+	if err != nil {
+		return None[V]()
+	}
+
+	return Some(value)
+}
+
+// SomeIf creates a new present option containing the value if cond is true,
+// and an empty option otherwise.
+func SomeIf[V any](cond bool, value V) T[V] {
+	return FromOk(value, cond)
+}
+
+// SomeIfFunc is like [SomeIf], but only computes the value if cond is true.
+func SomeIfFunc[V any](cond bool, fn func() V) T[V] {
+	if !cond {
+		return None[V]()
+	}
+
+	return Some(fn())
+}
+
+// Catch creates a new option containing the result of fn,
+// or an empty option if fn panics.
+//
+// The panic is recovered and discarded.
+func Catch[V any](fn func() V) (t T[V]) {
+	// track completion instead of checking the result of recover,
+	// to also catch panic(nil).
+	completed := false
+
+	defer func() {
+		if !completed {
+			_ = recover()
+			t = None[V]()
+		}
+	}()
+
+	value := fn()
+	completed = true
+
+	return Some(value)
+}
+
 // FromNillable creates a new option from a pointer.
 //
 // If the pointer is nil an empty option is returned,
@@ -98,6 +160,19 @@ func (t T[V]) ToNillable() *V {
 	return &value
 }
 
+// Deref collapses an option of a pointer into an option of the referenced value.
+//
+// An empty option is returned if the option is empty or the pointer is nil,
+// otherwise the referenced value is used as the value for a new present option.
+func Deref[V any](t T[*V]) T[V] {
+	pointer, present := t.Unwrap()
+	if !present {
+		return None[V]()
+	}
+
+	return FromNillable(pointer)
+}
+
 // FromZeroable creates a new option from a value.
 //
 // If the value is zero, an empty option is returned,
@@ -108,6 +183,13 @@ func (t T[V]) ToNillable() *V {
 //  1. If the value has an "IsZero() bool" method, it is used to determine zeroness,
 //  2. otherwise [reflect.Value#IsZero] is used.
 func FromZeroable[V any](value V) T[V] {
+	return FromZeroableFunc(value, isZero[V])
+}
+
+// FromZeroableFunc is like [FromZeroable],
+// but uses the given predicate to determine zeroness,
+// which allows domain-specific notions of emptiness, e.g. -1 meaning unset.
+func FromZeroableFunc[V any](value V, isZero func(V) bool) T[V] {
 	if isZero(value) {
 		return None[V]()
 	}
@@ -148,6 +230,28 @@ func (t T[V]) Must() V {
 	return value
 }
 
+// Expect returns the wrapped value and panics with the given message
+// if the [github.com/lukasngl/opt.T] is empty.
+func (t T[V]) Expect(msg string) V {
+	value, present := t.Unwrap()
+	if !present {
+		panic(msg)
+	}
+
+	return value
+}
+
+// Expectf is like [T.Expect], but formats the panic message
+// according to the given format specifier, see [fmt.Sprintf].
+func (t T[V]) Expectf(format string, args ...any) V {
+	value, present := t.Unwrap()
+	if !present {
+		panic(fmt.Sprintf(format, args...))
+	}
+
+	return value
+}
+
 // OrElse returns the wrapped value if not empty and the given default value otherwise.
 func (t T[V]) OrElse(defaultValue V) V {
 	value, present := t.Unwrap()
@@ -158,6 +262,19 @@ func (t T[V]) OrElse(defaultValue V) V {
 	return value
 }
 
+// OrElseGet returns the wrapped value if not empty
+// and the result of defaultValue otherwise.
+//
+// In contrast to [T.OrElse], the default value is only computed when needed.
+func (t T[V]) OrElseGet(defaultValue func() V) V {
+	value, present := t.Unwrap()
+	if !present {
+		return defaultValue()
+	}
+
+	return value
+}
+
 // OrElse returns the wrapped value if not empty and the zero value otherwise.
 func (t T[V]) OrZero() V {
 	value, _ := t.Unwrap()
@@ -165,6 +282,31 @@ func (t T[V]) OrZero() V {
 	return value
 }
 
+// OkOr returns the wrapped value if not empty and the given error otherwise.
+func (t T[V]) OkOr(err error) (V, error) {
+	value, present := t.Unwrap()
+	if !present {
+		var zero V
+
+		return zero, err
+	}
+
+	return value, nil
+}
+
+// OkOrFunc returns the wrapped value if not empty
+// and the error returned by fn otherwise.
+func (t T[V]) OkOrFunc(fn func() error) (V, error) {
+	value, present := t.Unwrap()
+	if !present {
+		var zero V
+
+		return zero, fn()
+	}
+
+	return value, nil
+}
+
 // Alias for the builtin type.
 type (
 	Bool = T[bool]
@@ -201,15 +343,29 @@ var (
 )
 
 // MarshalJSON implements [json.Marshaler].
+//
+// The value is marshaled through a pointer,
+// thus respecting [json.Marshaler] implemented by *V.
+//
+// Byte slices are encoded as base64 strings like plain []byte,
+// except that a present nil slice is encoded as an empty string instead of null,
+// use [RawBytes] to embed bytes, that already contain JSON.
 func (t T[V]) MarshalJSON() ([]byte, error) {
 	if !t.present {
 		return []byte("null"), nil
 	}
 
-	return json.Marshal(t.v)
+	if bytes, ok := any(t.v).([]byte); ok && bytes == nil {
+		return []byte(`""`), nil
+	}
+
+	return json.Marshal(&t.v)
 }
 
 // UnmarshalJSON implements [json.Unmarshaler].
+//
+// The value is unmarshaled into a fresh V, thus [json.Unmarshaler] implemented by *V
+// does not observe the previous value, and errors leave the option untouched.
 func (t *T[V]) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		t.present = false
@@ -217,12 +373,14 @@ func (t *T[V]) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	err := json.Unmarshal(data, &t.v)
+	var value V
+
+	err := json.Unmarshal(data, &value)
 	if err != nil {
 		return err
 	}
 
-	t.present = true
+	t.v, t.present = value, true
 
 	return nil
 }
@@ -234,7 +392,30 @@ var (
 )
 
 // Scan implements [sql.Scanner].
+//
+// NULL is scanned as an empty option, otherwise the value is scanned
+// into a fresh V using [sql.Scanner] implemented by *V, e.g. for decimal types,
+// falling back to the conversions of [sql.Null].
 func (t *T[V]) Scan(src any) error {
+	if src == nil {
+		*t = None[V]()
+
+		return nil
+	}
+
+	var value V
+
+	if scanner, ok := any(&value).(sql.Scanner); ok {
+		err := scanner.Scan(src)
+		if err != nil {
+			return err
+		}
+
+		*t = Some(value)
+
+		return nil
+	}
+
 	null := sql.Null[V]{}
 	err := null.Scan(src)
 
@@ -245,7 +426,15 @@ func (t *T[V]) Scan(src any) error {
 }
 
 // Value implements [driver.Valuer].
+//
+// Empty options are NULL, otherwise the value is passed through a pointer
+// to [driver.Valuer] implemented by V or *V, e.g. for decimal types,
+// falling back to the conversions of [sql.Null].
 func (t T[V]) Value() (driver.Value, error) {
+	if valuer, ok := any(&t.v).(driver.Valuer); ok && t.present {
+		return valuer.Value()
+	}
+
 	return sql.Null[V]{V: t.v, Valid: t.present}.Value()
 }
 
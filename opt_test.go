@@ -1,8 +1,12 @@
 package opt_test
 
 import (
+	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 	"testing/quick"
 	"time"
@@ -28,6 +32,17 @@ func ExampleT_OrElse() {
 	// Output: hello world!
 }
 
+func ExampleT_OrElseGet() {
+	something := opt.Some("hello")
+	nothing := opt.None[string]()
+
+	fmt.Printf("%s %s",
+		something.OrElseGet(func() string { panic("never called") }),
+		nothing.OrElseGet(func() string { return "world!" }),
+	)
+	// Output: hello world!
+}
+
 func ExampleT_Unwrap() {
 	something := opt.Some("hello")
 
@@ -37,6 +52,88 @@ func ExampleT_Unwrap() {
 	// Output: Some[string](hello) unwrapped to hello
 }
 
+func ExampleT_Expectf() {
+	defer func() {
+		fmt.Println(recover())
+	}()
+
+	port := opt.None[int]()
+	port.Expectf("missing required config %q", "port")
+	// Output: missing required config "port"
+}
+
+func TestExpect(t *testing.T) {
+	if value := opt.Some(8080).Expect("missing port"); value != 8080 {
+		t.Fatalf("expected 8080, got %d", value)
+	}
+
+	defer func() {
+		if msg := recover(); msg != "missing port" {
+			t.Fatalf("expected panic with the message, got %v", msg)
+		}
+	}()
+
+	opt.None[int]().Expect("missing port")
+	t.Fatal("expected Expect to panic on an empty option")
+}
+
+func ExampleT_OkOr() {
+	errNotFound := errors.New("not found")
+
+	fmt.Println(opt.Some("user").OkOr(errNotFound))
+	fmt.Println(opt.None[string]().OkOr(errNotFound))
+	// Output: user <nil>
+	//  not found
+}
+
+func ExampleT_OkOrFunc() {
+	id := 42
+
+	_, err := opt.None[string]().OkOrFunc(func() error {
+		return fmt.Errorf("user %d not found", id)
+	})
+
+	fmt.Println(err)
+	// Output: user 42 not found
+}
+
+func ExampleFromOk() {
+	ports := map[string]int{"http": 80}
+
+	port, ok := ports["http"]
+	fmt.Println(opt.FromOk(port, ok))
+
+	port, ok = ports["gopher"]
+	fmt.Println(opt.FromOk(port, ok))
+	// Output: Some[int](80)
+	// None[int]()
+}
+
+func ExampleFromErr() {
+	fmt.Println(opt.FromErr(strconv.Atoi("42")))
+	fmt.Println(opt.FromErr(strconv.Atoi("nope")))
+	// Output: Some[int](42)
+	// None[int]()
+}
+
+func ExampleSomeIf() {
+	verbose := false
+
+	fmt.Println(opt.SomeIf(verbose, "debug"))
+	fmt.Println(opt.SomeIfFunc(!verbose, func() string { return "info" }))
+	// Output: None[string]()
+	// Some[string](info)
+}
+
+func ExampleCatch() {
+	values := []string{"a", "b"}
+
+	fmt.Println(opt.Catch(func() string { return values[1] }))
+	fmt.Println(opt.Catch(func() string { return values[2] }))
+	// Output: Some[string](b)
+	// None[string]()
+}
+
 func ExampleFromNillable_nil() {
 	fmt.Printf("%s\n", opt.FromNillable((*time.Time)(nil)))
 	// Output: None[time.Time]()
@@ -67,6 +164,17 @@ func ExampleFromNillable_notZero() {
 	// Output: Some[time.Time](0001-01-01 01:01:01 +0000 UTC)
 }
 
+func ExampleDeref() {
+	value := "hello"
+
+	fmt.Println(opt.Deref(opt.Some(&value)))
+	fmt.Println(opt.Deref(opt.Some[*string](nil)))
+	fmt.Println(opt.Deref(opt.None[*string]()))
+	// Output: Some[string](hello)
+	// None[string]()
+	// None[string]()
+}
+
 func ExampleFromZeroable_nil() {
 	fmt.Printf("%s\n", opt.FromZeroable((*time.Time)(nil)))
 	// Output: None[*time.Time]()
@@ -102,6 +210,15 @@ func ExampleFromZeroable_notZero() {
 	// Some[*time.Time](0001-01-01 01:01:01 +0000 UTC)
 }
 
+func ExampleFromZeroableFunc() {
+	unset := func(v int) bool { return v == -1 }
+
+	fmt.Println(opt.FromZeroableFunc(-1, unset))
+	fmt.Println(opt.FromZeroableFunc(0, unset))
+	// Output: None[int]()
+	// Some[int](0)
+}
+
 type Thing struct {
 	Bool    opt.Bool    `json:"bool,"`
 	Byte    opt.Byte    `json:"byte"`
@@ -152,6 +269,119 @@ func TestMarshalIdentity(t *testing.T) {
 	}
 }
 
+// Level implements the json marshaling interfaces only on its pointer,
+// accumulating levels on unmarshal to observe the previous value.
+type Level int
+
+func (l *Level) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]string{"low", "high"}[*l])
+}
+
+func (l *Level) UnmarshalJSON(data []byte) error {
+	switch string(data) {
+	case `"low"`:
+		*l += 0
+	case `"high"`:
+		*l += 1
+	default:
+		return fmt.Errorf("invalid level %s", data)
+	}
+
+	return nil
+}
+
+func TestMarshalJSONPointerReceiver(t *testing.T) {
+	data, err := json.Marshal(opt.Some[Level](1))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `"high"` {
+		t.Fatalf("expected marshaler of *Level to be used, got %s", data)
+	}
+}
+
+func TestUnmarshalJSONPointerReceiver(t *testing.T) {
+	level := opt.Some[Level](1)
+
+	err := json.Unmarshal([]byte(`"high"`), &level)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Contains(level, 1) {
+		t.Fatalf("expected unmarshal into a fresh value, got %s", level)
+	}
+
+	err = json.Unmarshal([]byte(`"medium"`), &level)
+	if err == nil {
+		t.Fatal("expected error unmarshaling an invalid level")
+	}
+
+	if !opt.Contains(level, 1) {
+		t.Fatalf("expected error to leave the option untouched, got %s", level)
+	}
+}
+
+// Cents implements [sql.Scanner] and [driver.Valuer] only on its pointer,
+// scanning and valuing decimal strings like "12.34".
+type Cents int64
+
+func (c *Cents) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d.%02d", *c/100, *c%100), nil
+}
+
+func (c *Cents) Scan(src any) error {
+	text, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into cents", src)
+	}
+
+	units, fraction, _ := strings.Cut(text, ".")
+	fraction = (fraction + "00")[:2]
+
+	value, err := strconv.ParseInt(units+fraction, 10, 64)
+	if err != nil {
+		return err
+	}
+
+	*c = Cents(value)
+
+	return nil
+}
+
+func ExampleT_Scan() {
+	var price opt.T[Cents]
+
+	_ = price.Scan("12.34")
+	fmt.Println(price)
+
+	_ = price.Scan(nil)
+	fmt.Println(price)
+	// Output: Some[opt_test.Cents](1234)
+	// None[opt_test.Cents]()
+}
+
+func ExampleT_Value() {
+	fmt.Println(opt.Some[Cents](1234).Value())
+	fmt.Println(opt.None[Cents]().Value())
+	// Output: 12.34 <nil>
+	// <nil> <nil>
+}
+
+func TestScanScannerError(t *testing.T) {
+	price := opt.Some[Cents](1234)
+
+	err := price.Scan(42)
+	if err == nil {
+		t.Fatal("expected error scanning an integer into cents")
+	}
+
+	if !opt.Contains(price, 1234) {
+		t.Fatalf("expected error to leave the option untouched, got %s", price)
+	}
+}
+
 func TestFromNillableIdentity(t *testing.T) {
 	err := quick.Check(func(input opt.T[string]) bool {
 		to := input.ToNillable()
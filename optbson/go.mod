@@ -0,0 +1,9 @@
+module github.com/lukasngl/opt/optbson
+
+go 1.25.0
+
+replace github.com/lukasngl/opt => ../
+
+require github.com/lukasngl/opt v0.0.0
+
+require go.mongodb.org/mongo-driver/v2 v2.9.1
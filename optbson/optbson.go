@@ -0,0 +1,71 @@
+// Package optbson provides BSON support for options
+// using [go.mongodb.org/mongo-driver/v2/bson].
+package optbson
+
+import (
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/lukasngl/opt"
+)
+
+// BSON Marshalling und Unmarshalling.
+var (
+	_ bson.ValueMarshaler   = T[any]{}
+	_ bson.ValueUnmarshaler = &T[any]{}
+)
+
+// T is an option, that is encoded as null if empty, or otherwise as its value.
+//
+// Empty options can be omitted, i.e. stored as missing fields, using the omitempty tag option.
+type T[V any] struct {
+	opt.T[V]
+}
+
+// From wraps the given option.
+func From[V any](t opt.T[V]) T[V] {
+	return T[V]{T: t}
+}
+
+// Some returns a present option of the given value.
+func Some[V any](v V) T[V] {
+	return From(opt.Some(v))
+}
+
+// None returns an empty option.
+func None[V any]() T[V] {
+	return From(opt.None[V]())
+}
+
+// MarshalBSONValue implements [bson.ValueMarshaler].
+func (t T[V]) MarshalBSONValue() (byte, []byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return byte(bson.TypeNull), nil, nil
+	}
+
+	typ, data, err := bson.MarshalValue(value)
+
+	return byte(typ), data, err
+}
+
+// UnmarshalBSONValue implements [bson.ValueUnmarshaler].
+//
+// Both null and undefined are decoded as empty options.
+func (t *T[V]) UnmarshalBSONValue(typ byte, data []byte) error {
+	if bson.Type(typ) == bson.TypeNull || bson.Type(typ) == bson.TypeUndefined {
+		*t = None[V]()
+
+		return nil
+	}
+
+	var value V
+
+	err := bson.UnmarshalValue(bson.Type(typ), data, &value)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package optbson_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"go.mongodb.org/mongo-driver/v2/bson"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optbson"
+)
+
+type User struct {
+	Name     optbson.T[string] `bson:"name"`
+	Email    optbson.T[string] `bson:"email"`
+	Nickname optbson.T[string] `bson:"nickname,omitempty"`
+}
+
+func ExampleT_MarshalBSONValue() {
+	data, _ := bson.Marshal(User{
+		Name:     optbson.Some("gopher"),
+		Email:    optbson.None[string](),
+		Nickname: optbson.None[string](),
+	})
+
+	fmt.Println(bson.Raw(data))
+	// Output: {"name": "gopher","email": null}
+}
+
+func ExampleT_UnmarshalBSONValue() {
+	data, _ := bson.Marshal(bson.D{{Key: "name", Value: "gopher"}, {Key: "email", Value: nil}})
+
+	var user User
+
+	_ = bson.Unmarshal(data, &user)
+
+	fmt.Println(user.Name, user.Email, user.Nickname)
+	// Output: Some[string](gopher) None[string]() None[string]()
+}
+
+func TestIdentity(t *testing.T) {
+	type Thing struct {
+		Int    optbson.T[int64]          `bson:"int,omitempty"`
+		String optbson.T[string]         `bson:"string"`
+		Slice  optbson.T[[]string]       `bson:"slice"`
+		Map    optbson.T[map[string]int] `bson:"map"`
+	}
+
+	err := quick.Check(func(i opt.T[int64], s opt.T[string], l opt.T[[]string], m opt.T[map[string]int]) bool {
+		var de Thing
+
+		ser := Thing{Int: optbson.From(i), String: optbson.From(s), Slice: optbson.From(l), Map: optbson.From(m)}
+
+		data, err := bson.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = bson.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
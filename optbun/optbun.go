@@ -0,0 +1,162 @@
+// Package optbun provides support for options in [github.com/uptrace/bun],
+// deriving the column type, appender and scanner of option fields from the type of their value,
+// instead of treating options as [database/sql/driver.Valuer] of unknown type.
+package optbun
+
+import (
+	"reflect"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+	"github.com/uptrace/bun/schema"
+
+	"github.com/lukasngl/opt"
+)
+
+// RegisterModel registers the models like [bun.DB.RegisterModel]
+// and installs the column type, appender and scanner of the value for all option fields, e.g.
+// INTEGER for opt.T[int] or the JSON appender for opt.T[[]string] tagged "type:json".
+//
+// Empty options are appended as NULL and NULL is scanned as empty option,
+// see [ExcludeNone] to insert the DEFAULT of the column instead.
+//
+// Models must be registered before their first use, e.g. by queries or relations.
+func RegisterModel(db *bun.DB, models ...any) {
+	db.RegisterModel(models...)
+
+	for _, model := range models {
+		table := db.Table(reflect.TypeOf(model))
+
+		for _, field := range table.FieldMap {
+			elem, ok := opt.ValueTypeOf(field.StructField.Type)
+			if ok {
+				registerField(db.Dialect(), field, elem)
+			}
+		}
+	}
+}
+
+// registerField derives the field of the value from the option field,
+// as created by bun, before replacing the type, appender and scanner of the option field.
+func registerField(d schema.Dialect, field *schema.Field, elem reflect.Type) {
+	inner := field.Clone()
+	inner.StructField.Type = elem
+	inner.IsPtr = elem.Kind() == reflect.Pointer
+	inner.IndirectType = elem
+	if inner.IsPtr {
+		inner.IndirectType = elem.Elem()
+	}
+
+	inner.UserSQLType, _ = field.Tag.Option("type")
+	inner.CreateTableSQLType = ""
+	inner.DiscoveredSQLType = schema.DiscoverSQLType(inner.IndirectType)
+	inner.Append = schema.FieldAppender(d, inner)
+	inner.Scan = schema.FieldScanner(d, inner)
+
+	// The dialect adjusts the type, e.g. INTEGER for sqlite,
+	// and may replace the appender and scanner, e.g. for postgres arrays.
+	d.OnTable(&schema.Table{FieldMap: map[string]*schema.Field{inner.Name: inner}}) //nolint:exhaustruct
+
+	field.DiscoveredSQLType = inner.DiscoveredSQLType
+	if inner.UserSQLType == "" {
+		field.UserSQLType = inner.DiscoveredSQLType
+	}
+
+	if inner.CreateTableSQLType != "" {
+		field.CreateTableSQLType = inner.CreateTableSQLType
+	} else {
+		field.CreateTableSQLType = field.UserSQLType
+	}
+
+	field.Append = appender(inner)
+	field.Scan = scanner(inner, elem)
+}
+
+// appender appends empty options as NULL and present options using the appender of the value.
+func appender(inner *schema.Field) schema.AppenderFunc {
+	return func(gen schema.QueryGen, b []byte, v reflect.Value) []byte {
+		if !v.CanAddr() {
+			ptr := reflect.New(v.Type())
+			ptr.Elem().Set(v)
+			v = ptr.Elem()
+		}
+
+		value, present := v.Addr().Interface().(opt.Optional).GetAny()
+		if !present || value == nil {
+			return dialect.AppendNull(b)
+		}
+
+		rv := reflect.New(inner.StructField.Type).Elem()
+		rv.Set(reflect.ValueOf(value))
+
+		if inner.IsPtr && rv.IsNil() {
+			return dialect.AppendNull(b)
+		}
+
+		return inner.Append(gen, b, rv)
+	}
+}
+
+// scanner scans NULL as empty option and other values using the scanner of the value.
+func scanner(inner *schema.Field, elem reflect.Type) schema.ScannerFunc {
+	return func(dest reflect.Value, src any) error {
+		option := dest.Addr().Interface().(opt.Optional)
+		if src == nil {
+			return option.SetAny(nil)
+		}
+
+		value := reflect.New(elem)
+
+		err := inner.ScanWithCheck(value.Elem(), src)
+		if err != nil {
+			return err
+		}
+
+		return option.SetAny(value.Elem().Interface())
+	}
+}
+
+// ExcludeNone excludes the columns of option fields, which are empty in all rows of the model,
+// such that the DEFAULT of the column is inserted instead of NULL.
+//
+// Alternatively, bun inserts the "default" tag of fields with empty options,
+// or DEFAULT for fields tagged "nullzero", if supported by the dialect.
+func ExcludeNone(q *bun.InsertQuery) *bun.InsertQuery {
+	model, ok := q.GetModel().(bun.TableModel)
+	if !ok {
+		return q
+	}
+
+	rows := reflect.Indirect(reflect.ValueOf(model.Value()))
+	if rows.Kind() == reflect.Struct {
+		rows = reflect.Append(reflect.MakeSlice(reflect.SliceOf(rows.Type()), 0, 1), rows)
+	}
+
+	var columns []string
+
+	for _, field := range model.Table().Fields {
+		if _, ok := opt.ValueTypeOf(field.StructField.Type); !ok || !allNone(field, rows) {
+			continue
+		}
+
+		columns = append(columns, field.Name)
+	}
+
+	if len(columns) == 0 {
+		return q
+	}
+
+	return q.ExcludeColumn(columns...)
+}
+
+// allNone reports, whether the option field is empty in all rows.
+func allNone(field *schema.Field, rows reflect.Value) bool {
+	for i := 0; i < rows.Len(); i++ {
+		row := reflect.Indirect(rows.Index(i))
+		if row.Kind() == reflect.Struct && !field.HasZeroValue(row) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,214 @@
+package optbun_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net/netip"
+	"reflect"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	"github.com/uptrace/bun/driver/sqliteshim"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optbun"
+)
+
+type User struct {
+	ID       int64 `bun:",pk,autoincrement"`
+	Name     opt.T[string]
+	Age      opt.T[int]
+	Born     opt.T[time.Time]
+	Verified opt.Bool
+	Address  opt.T[netip.Addr]
+	Tags     opt.T[[]string] `bun:",type:json"`
+}
+
+func open(t testing.TB) *bun.DB {
+	t.Helper()
+
+	sqldb, err := sql.Open(sqliteshim.ShimName, ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sqldb.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqldb.Close() })
+
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+	optbun.RegisterModel(db, (*User)(nil))
+
+	_, err = db.NewCreateTable().Model((*User)(nil)).Exec(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func ExampleRegisterModel() {
+	ctx := context.Background()
+	sqldb, _ := sql.Open(sqliteshim.ShimName, ":memory:")
+	sqldb.SetMaxOpenConns(1)
+
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+	optbun.RegisterModel(db, (*User)(nil))
+
+	fmt.Println(db.NewCreateTable().Model((*User)(nil)))
+
+	_, _ = db.NewCreateTable().Model((*User)(nil)).Exec(ctx)
+	_, _ = db.NewInsert().Model(&User{Name: opt.Some("gopher"), Tags: opt.Some([]string{"go"})}).Exec(ctx)
+
+	var user User
+
+	_ = db.NewSelect().Model(&user).Limit(1).Scan(ctx)
+	fmt.Println(user.Name, user.Age, user.Tags)
+	// Output:
+	// CREATE TABLE "users" ("id" INTEGER NOT NULL PRIMARY KEY AUTOINCREMENT, "name" VARCHAR, "age" INTEGER, "born" TIMESTAMP, "verified" BOOLEAN, "address" VARCHAR, "tags" json)
+	// Some[string](gopher) None[int]() Some[[]string]([go])
+}
+
+func ExampleExcludeNone() {
+	sqldb, _ := sql.Open(sqliteshim.ShimName, ":memory:")
+	sqldb.SetMaxOpenConns(1)
+
+	db := bun.NewDB(sqldb, sqlitedialect.New())
+	optbun.RegisterModel(db, (*User)(nil))
+
+	fmt.Println(db.NewInsert().Model(&User{Name: opt.Some("gopher")}))
+	fmt.Println(optbun.ExcludeNone(db.NewInsert().Model(&User{Name: opt.Some("gopher")})))
+	// Output:
+	// INSERT INTO "users" ("name", "age", "born", "verified", "address", "tags") VALUES ('gopher', NULL, NULL, NULL, NULL, NULL) RETURNING "id"
+	// INSERT INTO "users" ("id", "name") VALUES (NULL, 'gopher') RETURNING "id"
+}
+
+func TestNull(t *testing.T) {
+	db := open(t)
+	ctx := context.Background()
+
+	_, err := db.NewInsert().Model(&User{}).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nulls, err := db.NewSelect().Model((*User)(nil)).
+		Where("name IS NULL AND age IS NULL AND born IS NULL AND verified IS NULL AND address IS NULL AND tags IS NULL").
+		Count(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nulls != 1 {
+		t.Fatal("expected empty options to be stored as NULL")
+	}
+}
+
+func TestExcludeNone(t *testing.T) {
+	db := open(t)
+	ctx := context.Background()
+
+	// e.g. created by a migration
+	_, err := db.ExecContext(ctx, `DROP TABLE users; CREATE TABLE users (id INTEGER PRIMARY KEY, name VARCHAR,
+		age INTEGER DEFAULT 42, born TIMESTAMP, verified BOOLEAN, address VARCHAR, tags json)`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	users := []User{{Name: opt.Some("gopher")}, {Name: opt.Some("ferris"), Verified: opt.Some(true)}}
+
+	_, err = optbun.ExcludeNone(db.NewInsert().Model(&users)).Exec(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var de []User
+
+	err = db.NewSelect().Model(&de).Order("id").Scan(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(de) != 2 || !opt.Contains(de[0].Age, 42) || !opt.Contains(de[1].Age, 42) {
+		t.Fatalf("expected the default of columns empty in all rows, got %v", de)
+	}
+
+	if de[0].Verified.IsPresent() || !opt.Contains(de[1].Verified, true) {
+		t.Fatalf("expected columns present in any row to be inserted, got %v", de)
+	}
+}
+
+type Post struct {
+	ID   int64           `bun:",pk,autoincrement"`
+	Tags opt.T[[]string] `bun:",array"`
+}
+
+func TestPostgresArray(t *testing.T) {
+	db := bun.NewDB(&sql.DB{}, pgdialect.New())
+	optbun.RegisterModel(db, (*Post)(nil))
+
+	create := db.NewCreateTable().Model((*Post)(nil)).String()
+	if want := `CREATE TABLE "posts" ("id" BIGSERIAL NOT NULL, "tags" VARCHAR[], PRIMARY KEY ("id"))`; create != want {
+		t.Fatalf("expected %s, got %s", want, create)
+	}
+
+	insert := db.NewInsert().Model(&[]Post{{ID: 1, Tags: opt.Some([]string{"go"})}, {ID: 2}}).String()
+	if want := `INSERT INTO "posts" ("id", "tags") VALUES (1, '{"go"}'), (2, NULL)`; insert != want {
+		t.Fatalf("expected %s, got %s", want, insert)
+	}
+
+	var post Post
+
+	err := db.Table(reflect.TypeFor[Post]()).FieldMap["tags"].ScanValue(reflect.ValueOf(&post).Elem(), []byte(`{"go"}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(post.Tags.OrZero()) != 1 {
+		t.Fatalf("expected array to be scanned, got %s", post.Tags)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	db := open(t)
+	ctx := context.Background()
+
+	err := quick.Check(func(name opt.T[string], age opt.T[int], seconds opt.T[int32], verified opt.Bool, address opt.T[[4]byte]) bool {
+		ser := User{
+			Name:     name,
+			Age:      age,
+			Born:     opt.Map(seconds, func(s int32) time.Time { return time.Unix(int64(s), 0).UTC() }),
+			Verified: verified,
+			Address:  opt.Map(address, netip.AddrFrom4),
+		}
+
+		_, err := db.NewInsert().Model(&ser).Exec(ctx)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		var de User
+
+		err = db.NewSelect().Model(&de).Where("id = ?", ser.ID).Scan(ctx)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/lukasngl/opt/optcbor
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/fxamacker/cbor/v2 v2.9.4
+	github.com/lukasngl/opt v0.0.0
+)
+
+require github.com/x448/float16 v0.8.4 // indirect
@@ -0,0 +1,78 @@
+// Package optcbor provides CBOR support for options using [github.com/fxamacker/cbor/v2].
+//
+// Plain options are encoded by cbor as byte strings via [opt.T.MarshalBinary],
+// use [T] to encode them as the inner value or null instead.
+package optcbor
+
+import (
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/lukasngl/opt"
+)
+
+const (
+	cborNull      = 0xf6
+	cborUndefined = 0xf7
+)
+
+// CBOR Marshalling und Unmarshalling.
+var (
+	_ cbor.Marshaler   = T[any]{}
+	_ cbor.Unmarshaler = &T[any]{}
+)
+
+// T is an option, that is encoded as null if empty, or otherwise as its value.
+//
+// Empty options can be omitted using the omitzero tag option.
+type T[V any] struct {
+	opt.T[V]
+}
+
+// From wraps the given option.
+func From[V any](t opt.T[V]) T[V] {
+	return T[V]{T: t}
+}
+
+// Some returns a present option of the given value.
+func Some[V any](v V) T[V] {
+	return From(opt.Some(v))
+}
+
+// None returns an empty option.
+func None[V any]() T[V] {
+	return From(opt.None[V]())
+}
+
+// MarshalCBOR implements [cbor.Marshaler].
+//
+// Note that the value is encoded using the default encoding options.
+func (t T[V]) MarshalCBOR() ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return []byte{cborNull}, nil
+	}
+
+	return cbor.Marshal(value)
+}
+
+// UnmarshalCBOR implements [cbor.Unmarshaler].
+//
+// Both null and undefined are decoded as empty options.
+func (t *T[V]) UnmarshalCBOR(data []byte) error {
+	if len(data) == 1 && (data[0] == cborNull || data[0] == cborUndefined) {
+		*t = None[V]()
+
+		return nil
+	}
+
+	var value V
+
+	err := cbor.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
@@ -0,0 +1,92 @@
+package optcbor_test
+
+import (
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optcbor"
+)
+
+type Claims struct {
+	Subject  optcbor.T[string] `cbor:"1,keyasint,omitzero"`
+	Audience optcbor.T[string] `cbor:"2,keyasint,omitzero"`
+	Expires  optcbor.T[int64]  `cbor:"3,keyasint"`
+}
+
+func ExampleT_MarshalCBOR() {
+	data, _ := cbor.Marshal(Claims{
+		Subject:  optcbor.Some("gopher"),
+		Audience: optcbor.None[string](),
+		Expires:  optcbor.None[int64](),
+	})
+
+	fmt.Println(hex.EncodeToString(data))
+	// Output: a20166676f7068657203f6
+}
+
+func ExampleT_UnmarshalCBOR() {
+	data, _ := hex.DecodeString("a20166676f7068657203f6")
+
+	var claims Claims
+
+	_ = cbor.Unmarshal(data, &claims)
+
+	fmt.Println(claims.Subject, claims.Audience, claims.Expires)
+	// Output: Some[string](gopher) None[string]() None[int64]()
+}
+
+func TestUndefined(t *testing.T) {
+	value := optcbor.Some(42)
+
+	err := cbor.Unmarshal([]byte{0xf7}, &value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if value.IsPresent() {
+		t.Fatalf("expected undefined to be decoded as empty, got %s", value)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	type Thing struct {
+		Int    optcbor.T[int]     `cbor:"int,omitzero"`
+		String optcbor.T[string]  `cbor:"string"`
+		Bytes  optcbor.T[[]byte]  `cbor:"bytes"`
+		Nested optcbor.T[[]int64] `cbor:"nested"`
+	}
+
+	err := quick.Check(func(i opt.T[int], s opt.T[string], b opt.T[[]byte], n opt.T[[]int64]) bool {
+		var de Thing
+
+		ser := Thing{Int: optcbor.From(i), String: optcbor.From(s), Bytes: optcbor.From(b), Nested: optcbor.From(n)}
+
+		data, err := cbor.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = cbor.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
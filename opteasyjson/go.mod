@@ -0,0 +1,12 @@
+module github.com/lukasngl/opt/opteasyjson
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/lukasngl/opt v0.0.0
+	github.com/mailru/easyjson v0.9.2
+)
+
+require github.com/josharian/intern v1.0.0 // indirect
@@ -0,0 +1,26 @@
+// Package testdata contains types with generated easyjson code.
+package testdata
+
+//go:generate go run github.com/mailru/easyjson/easyjson -all user.go
+
+import (
+	"time"
+
+	"github.com/lukasngl/opt/opteasyjson"
+)
+
+type User struct {
+	Name     opteasyjson.String               `json:"name"`
+	Email    opteasyjson.String               `json:"email,omitempty"`
+	Age      opteasyjson.Int64                `json:"age"`
+	Score    opteasyjson.Float64              `json:"score"`
+	Admin    opteasyjson.Bool                 `json:"admin"`
+	Created  opteasyjson.T[time.Time]         `json:"created"`
+	Address  opteasyjson.T[Address]           `json:"address"`
+	Settings opteasyjson.T[map[string]string] `json:"settings"`
+}
+
+type Address struct {
+	City string             `json:"city"`
+	Zip  opteasyjson.String `json:"zip"`
+}
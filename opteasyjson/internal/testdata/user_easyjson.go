@@ -0,0 +1,237 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package testdata
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjson9e1087fdDecodeGithubComLukasnglOptOpteasyjsonInternalTestdata(in *jlexer.Lexer, out *User) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "name":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Name).UnmarshalEasyJSON(in)
+			}
+		case "email":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Email).UnmarshalEasyJSON(in)
+			}
+		case "age":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Age).UnmarshalEasyJSON(in)
+			}
+		case "score":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Score).UnmarshalEasyJSON(in)
+			}
+		case "admin":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Admin).UnmarshalEasyJSON(in)
+			}
+		case "created":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Created).UnmarshalEasyJSON(in)
+			}
+		case "address":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Address).UnmarshalEasyJSON(in)
+			}
+		case "settings":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Settings).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9e1087fdEncodeGithubComLukasnglOptOpteasyjsonInternalTestdata(out *jwriter.Writer, in User) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		(in.Name).MarshalEasyJSON(out)
+	}
+	if (in.Email).IsDefined() {
+		const prefix string = ",\"email\":"
+		out.RawString(prefix)
+		(in.Email).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"age\":"
+		out.RawString(prefix)
+		(in.Age).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"score\":"
+		out.RawString(prefix)
+		(in.Score).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"admin\":"
+		out.RawString(prefix)
+		(in.Admin).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"created\":"
+		out.RawString(prefix)
+		(in.Created).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"address\":"
+		out.RawString(prefix)
+		(in.Address).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"settings\":"
+		out.RawString(prefix)
+		(in.Settings).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v User) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9e1087fdEncodeGithubComLukasnglOptOpteasyjsonInternalTestdata(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v User) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9e1087fdEncodeGithubComLukasnglOptOpteasyjsonInternalTestdata(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *User) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9e1087fdDecodeGithubComLukasnglOptOpteasyjsonInternalTestdata(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *User) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9e1087fdDecodeGithubComLukasnglOptOpteasyjsonInternalTestdata(l, v)
+}
+func easyjson9e1087fdDecodeGithubComLukasnglOptOpteasyjsonInternalTestdata1(in *jlexer.Lexer, out *Address) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		switch key {
+		case "city":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				out.City = string(in.String())
+			}
+		case "zip":
+			if in.IsNull() {
+				in.Skip()
+			} else {
+				(out.Zip).UnmarshalEasyJSON(in)
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjson9e1087fdEncodeGithubComLukasnglOptOpteasyjsonInternalTestdata1(out *jwriter.Writer, in Address) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"city\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.City))
+	}
+	{
+		const prefix string = ",\"zip\":"
+		out.RawString(prefix)
+		(in.Zip).MarshalEasyJSON(out)
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Address) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjson9e1087fdEncodeGithubComLukasnglOptOpteasyjsonInternalTestdata1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Address) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjson9e1087fdEncodeGithubComLukasnglOptOpteasyjsonInternalTestdata1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Address) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjson9e1087fdDecodeGithubComLukasnglOptOpteasyjsonInternalTestdata1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Address) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjson9e1087fdDecodeGithubComLukasnglOptOpteasyjsonInternalTestdata1(l, v)
+}
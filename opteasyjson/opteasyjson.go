@@ -0,0 +1,174 @@
+// Package opteasyjson provides options for [github.com/mailru/easyjson],
+// which implement its marshaling interfaces, thus fields of generated code
+// do not fall back to encoding/json.
+package opteasyjson
+
+import (
+	"encoding/json"
+
+	"github.com/mailru/easyjson"
+	"github.com/mailru/easyjson/jlexer"
+	"github.com/mailru/easyjson/jwriter"
+
+	"github.com/lukasngl/opt"
+)
+
+// EasyJSON Marshalling und Unmarshalling.
+var (
+	_ easyjson.Marshaler   = T[any]{}
+	_ easyjson.Unmarshaler = &T[any]{}
+	_ easyjson.Optional    = T[any]{}
+)
+
+// T is an option, that is encoded as null if empty, or otherwise as its value.
+//
+// Empty options can be omitted using the omitempty tag option.
+type T[V any] struct {
+	opt.T[V]
+}
+
+// Aliases for the builtin types, that are encoded without reflection.
+type (
+	Bool = T[bool]
+
+	String = T[string]
+
+	Int   = T[int]
+	Int8  = T[int8]
+	Int16 = T[int16]
+	Int32 = T[int32]
+	Int64 = T[int64]
+
+	Uint   = T[uint]
+	Uint8  = T[uint8]
+	Uint16 = T[uint16]
+	Uint32 = T[uint32]
+	Uint64 = T[uint64]
+
+	Float32 = T[float32]
+	Float64 = T[float64]
+)
+
+// From wraps the given option.
+func From[V any](t opt.T[V]) T[V] {
+	return T[V]{T: t}
+}
+
+// Some returns a present option of the given value.
+func Some[V any](v V) T[V] {
+	return From(opt.Some(v))
+}
+
+// None returns an empty option.
+func None[V any]() T[V] {
+	return From(opt.None[V]())
+}
+
+// IsDefined implements [easyjson.Optional], reporting whether the option is present.
+func (t T[V]) IsDefined() bool {
+	return t.IsPresent()
+}
+
+// MarshalEasyJSON implements [easyjson.Marshaler].
+//
+// Values of builtin types and [easyjson.Marshaler] are written directly,
+// while others are encoded using encoding/json.
+func (t T[V]) MarshalEasyJSON(w *jwriter.Writer) {
+	value, present := t.Unwrap()
+	if !present {
+		w.RawString("null")
+
+		return
+	}
+
+	switch v := any(value).(type) {
+	case bool:
+		w.Bool(v)
+	case string:
+		w.String(v)
+	case int:
+		w.Int(v)
+	case int8:
+		w.Int8(v)
+	case int16:
+		w.Int16(v)
+	case int32:
+		w.Int32(v)
+	case int64:
+		w.Int64(v)
+	case uint:
+		w.Uint(v)
+	case uint8:
+		w.Uint8(v)
+	case uint16:
+		w.Uint16(v)
+	case uint32:
+		w.Uint32(v)
+	case uint64:
+		w.Uint64(v)
+	case float32:
+		w.Float32(v)
+	case float64:
+		w.Float64(v)
+	case easyjson.Marshaler:
+		v.MarshalEasyJSON(w)
+	default:
+		// marshal a pointer, to also use marshalers implemented by *V, like [opt.T.MarshalJSON].
+		w.Raw(json.Marshal(&value))
+	}
+}
+
+// UnmarshalEasyJSON implements [easyjson.Unmarshaler], as the inverse of [T.MarshalEasyJSON].
+func (t *T[V]) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	if l.IsNull() {
+		l.Skip()
+
+		t.T = opt.None[V]()
+
+		return
+	}
+
+	var value V
+
+	switch value := any(&value).(type) {
+	case *bool:
+		*value = l.Bool()
+	case *string:
+		*value = l.String()
+	case *int:
+		*value = l.Int()
+	case *int8:
+		*value = l.Int8()
+	case *int16:
+		*value = l.Int16()
+	case *int32:
+		*value = l.Int32()
+	case *int64:
+		*value = l.Int64()
+	case *uint:
+		*value = l.Uint()
+	case *uint8:
+		*value = l.Uint8()
+	case *uint16:
+		*value = l.Uint16()
+	case *uint32:
+		*value = l.Uint32()
+	case *uint64:
+		*value = l.Uint64()
+	case *float32:
+		*value = l.Float32()
+	case *float64:
+		*value = l.Float64()
+	case easyjson.Unmarshaler:
+		value.UnmarshalEasyJSON(l)
+	default:
+		data := l.Raw()
+		if l.Ok() {
+			l.AddError(json.Unmarshal(data, value))
+		}
+	}
+
+	if l.Ok() {
+		t.T = opt.Some(value)
+	}
+}
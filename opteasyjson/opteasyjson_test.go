@@ -0,0 +1,110 @@
+package opteasyjson_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/mailru/easyjson"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/opteasyjson"
+	"github.com/lukasngl/opt/opteasyjson/internal/testdata"
+)
+
+func ExampleT_MarshalEasyJSON() {
+	data, _ := easyjson.Marshal(testdata.User{
+		Name:    opteasyjson.Some("gopher"),
+		Age:     opteasyjson.Some[int64](13),
+		Created: opteasyjson.Some(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)),
+		Address: opteasyjson.Some(testdata.Address{City: "Berlin"}),
+	})
+
+	fmt.Println(string(data))
+	// Output: {"name":"gopher","age":13,"score":null,"admin":null,"created":"2024-01-02T03:04:05Z","address":{"city":"Berlin","zip":null},"settings":null}
+}
+
+func ExampleT_UnmarshalEasyJSON() {
+	var user testdata.User
+
+	_ = easyjson.Unmarshal([]byte(`{"name":null,"email":"gopher@go.dev","admin":true,"settings":{"theme":"dark"}}`), &user)
+
+	fmt.Println(user.Name, user.Email, user.Admin, user.Settings)
+	// Output: None[string]() Some[string](gopher@go.dev) Some[bool](true) Some[map[string]string](map[theme:dark])
+}
+
+func TestUnmarshalInvalid(t *testing.T) {
+	var user testdata.User
+
+	err := easyjson.Unmarshal([]byte(`{"age":"13"}`), &user)
+	if err == nil {
+		t.Fatalf("expected error decoding a string into an int, got %v", user)
+	}
+
+	err = easyjson.Unmarshal([]byte(`{"created":13}`), &user)
+	if err == nil {
+		t.Fatalf("expected error decoding a number into a time, got %v", user)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	err := quick.Check(func(name, email opt.T[string], age opt.T[int64], score opt.T[float64], admin opt.T[bool]) bool {
+		var de testdata.User
+
+		ser := testdata.User{
+			Name:  opteasyjson.From(name),
+			Email: opteasyjson.From(email),
+			Age:   opteasyjson.From(age),
+			Score: opteasyjson.From(score),
+			Admin: opteasyjson.From(admin),
+		}
+
+		data, err := easyjson.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = easyjson.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Celsius implements [json.Marshaler] on its pointer.
+type Celsius float64
+
+func (c *Celsius) MarshalJSON() ([]byte, error) {
+	return []byte(fmt.Sprintf(`"%.1f°C"`, float64(*c))), nil
+}
+
+func TestMarshalPointerMarshaler(t *testing.T) {
+	data, err := easyjson.Marshal(opteasyjson.Some(Celsius(21.5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := json.Marshal(opt.Some(Celsius(21.5)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != string(expected) || string(data) != `"21.5°C"` {
+		t.Fatalf("expected %s, got %s", expected, data)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/optent
+
+go 1.24
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	entgo.io/ent v0.14.6
+	github.com/lukasngl/opt v0.0.0
+)
@@ -0,0 +1,74 @@
+// Package optent provides optional fields for [entgo.io/ent] schemas,
+// whose Go type is an option instead of a nillable pointer.
+//
+// As options implement [database/sql/driver.Valuer] and [database/sql.Scanner],
+// they are valid GoTypes of ent fields, which [Field] configures for the kind of the value.
+// For further configuration, use the builders of ent directly, e.g.:
+//
+//	field.String("nickname").GoType(opt.String{}).Optional().MaxLen(32)
+package optent
+
+import (
+	"reflect"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/lukasngl/opt"
+)
+
+// Field returns an optional field, whose Go type is opt.T[V],
+// using the builder for the kind of V, e.g. [field.String] for strings,
+// thus ent infers the same column types as for plain V fields.
+//
+// Besides the builtin kinds, [time.Time], byte slices,
+// and 16 byte arrays like [github.com/google/uuid.UUID] are supported.
+// Other values are reported as [field.Other] lacking a SchemaType,
+// use [field.Other] directly to set it.
+func Field[V any](name string) ent.Field {
+	typ := opt.None[V]()
+	rt := reflect.TypeFor[V]()
+
+	switch {
+	case rt.ConvertibleTo(reflect.TypeFor[time.Time]()):
+		return field.Time(name).GoType(typ).Optional()
+	case rt.Kind() == reflect.Array && rt.Len() == 16 && rt.Elem().Kind() == reflect.Uint8:
+		return field.UUID(name, typ).Optional()
+	case rt.Kind() == reflect.Slice && rt.Elem().Kind() == reflect.Uint8:
+		return field.Bytes(name).GoType(typ).Optional()
+	}
+
+	switch rt.Kind() {
+	case reflect.Bool:
+		return field.Bool(name).GoType(typ).Optional()
+	case reflect.String:
+		return field.String(name).GoType(typ).Optional()
+	case reflect.Int:
+		return field.Int(name).GoType(typ).Optional()
+	case reflect.Int8:
+		return field.Int8(name).GoType(typ).Optional()
+	case reflect.Int16:
+		return field.Int16(name).GoType(typ).Optional()
+	case reflect.Int32:
+		return field.Int32(name).GoType(typ).Optional()
+	case reflect.Int64:
+		return field.Int64(name).GoType(typ).Optional()
+	case reflect.Uint:
+		return field.Uint(name).GoType(typ).Optional()
+	case reflect.Uint8:
+		return field.Uint8(name).GoType(typ).Optional()
+	case reflect.Uint16:
+		return field.Uint16(name).GoType(typ).Optional()
+	case reflect.Uint32:
+		return field.Uint32(name).GoType(typ).Optional()
+	case reflect.Uint64:
+		return field.Uint64(name).GoType(typ).Optional()
+	case reflect.Float32:
+		return field.Float32(name).GoType(typ).Optional()
+	case reflect.Float64:
+		return field.Float(name).GoType(typ).Optional()
+	default:
+		return field.Other(name, typ).Optional()
+	}
+}
@@ -0,0 +1,74 @@
+package optent_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+
+	"github.com/lukasngl/opt/optent"
+)
+
+// User is an ent schema with optional fields.
+type User struct {
+	ent.Schema
+}
+
+func (User) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name"),
+		optent.Field[string]("nickname"),
+		optent.Field[int]("age"),
+		optent.Field[time.Time]("born"),
+	}
+}
+
+func ExampleField() {
+	for _, f := range (User{}).Fields() {
+		descriptor := f.Descriptor()
+
+		fmt.Println(descriptor.Name, descriptor.Info.Type, descriptor.Info, descriptor.Optional)
+	}
+	// Output:
+	// name string string false
+	// nickname string opt.T[string] true
+	// age int opt.T[int] true
+	// born time.Time opt.T[time.Time] true
+}
+
+func TestField(t *testing.T) {
+	for want, f := range map[field.Type]ent.Field{
+		field.TypeBool:    optent.Field[bool]("bool"),
+		field.TypeInt8:    optent.Field[int8]("int8"),
+		field.TypeUint64:  optent.Field[uint64]("uint64"),
+		field.TypeFloat32: optent.Field[float32]("float32"),
+		field.TypeFloat64: optent.Field[float64]("float64"),
+		field.TypeBytes:   optent.Field[[]byte]("bytes"),
+		field.TypeUUID:    optent.Field[[16]byte]("uuid"),
+	} {
+		descriptor := f.Descriptor()
+
+		if descriptor.Err != nil {
+			t.Errorf("unexpected error for %s: %v", descriptor.Name, descriptor.Err)
+		}
+
+		if descriptor.Info.Type != want {
+			t.Errorf("expected %s for %s, got %s", want, descriptor.Name, descriptor.Info.Type)
+		}
+
+		if !descriptor.Optional {
+			t.Errorf("expected %s to be optional", descriptor.Name)
+		}
+	}
+}
+
+func TestFieldOther(t *testing.T) {
+	descriptor := optent.Field[netip.Addr]("addr").Descriptor()
+
+	if descriptor.Err == nil {
+		t.Fatal("expected error for a field of other type without SchemaType")
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/optform
+
+go 1.26
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/go-playground/form/v4 v4.5.0
+	github.com/lukasngl/opt v0.0.0
+)
@@ -0,0 +1,76 @@
+// Package optform provides support for options in [github.com/go-playground/form/v4].
+package optform
+
+import (
+	"time"
+
+	"github.com/go-playground/form/v4"
+
+	"github.com/lukasngl/opt"
+)
+
+// Register registers options of the builtin types and [time.Time]
+// with the given encoder and decoder, see [RegisterType].
+func Register(enc *form.Encoder, dec *form.Decoder) {
+	for _, register := range []func(*form.Encoder, *form.Decoder){
+		RegisterType[bool],
+		RegisterType[string],
+		RegisterType[int],
+		RegisterType[int8],
+		RegisterType[int16],
+		RegisterType[int32],
+		RegisterType[int64],
+		RegisterType[uint],
+		RegisterType[uint8],
+		RegisterType[uint16],
+		RegisterType[uint32],
+		RegisterType[uint64],
+		RegisterType[float32],
+		RegisterType[float64],
+		RegisterType[time.Time],
+	} {
+		register(enc, dec)
+	}
+}
+
+// RegisterType registers options of V with the given encoder and decoder,
+// either of which may be nil.
+//
+// Empty options are omitted, while absent fields are decoded as empty options.
+// Values are encoded as text, see [opt.T.MarshalText], thus empty values are decoded
+// as empty options as well, as submitted by empty form inputs.
+func RegisterType[V any](enc *form.Encoder, dec *form.Decoder) {
+	if enc != nil {
+		enc.RegisterCustomTypeFunc(encode[V], opt.T[V]{})
+	}
+
+	if dec != nil {
+		dec.RegisterCustomTypeFunc(decode[V], opt.T[V]{})
+	}
+}
+
+func encode[V any](x any) ([]string, error) {
+	t, _ := x.(opt.T[V])
+	if !t.IsPresent() {
+		return nil, nil
+	}
+
+	text, err := t.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return []string{string(text)}, nil
+}
+
+func decode[V any](values []string) (any, error) {
+	var t opt.T[V]
+
+	if len(values) == 0 {
+		return t, nil
+	}
+
+	err := t.UnmarshalText([]byte(values[0]))
+
+	return t, err
+}
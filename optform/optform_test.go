@@ -0,0 +1,92 @@
+package optform_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"testing/quick"
+
+	"github.com/go-playground/form/v4"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optform"
+)
+
+type Signup struct {
+	Email    string        `form:"email"`
+	Name     opt.T[string] `form:"name"`
+	Age      opt.T[int]    `form:"age"`
+	Referrer opt.T[string] `form:"referrer"`
+}
+
+func ExampleRegister() {
+	enc, dec := form.NewEncoder(), form.NewDecoder()
+	optform.Register(enc, dec)
+
+	var signup Signup
+
+	_ = dec.Decode(&signup, url.Values{"email": {"gopher@go.dev"}, "age": {"13"}, "referrer": {""}})
+
+	fmt.Println(signup.Email, signup.Name, signup.Age, signup.Referrer)
+
+	values, _ := enc.Encode(signup)
+
+	fmt.Println(values.Encode())
+	// Output:
+	// gopher@go.dev None[string]() Some[int](13) None[string]()
+	// age=13&email=gopher%40go.dev
+}
+
+func TestRegisterType(t *testing.T) {
+	type Point struct{ X, Y int }
+
+	dec := form.NewDecoder()
+	optform.RegisterType[Point](nil, dec)
+
+	var value struct {
+		Point opt.T[Point]
+	}
+
+	err := dec.Decode(&value, url.Values{"Point": {"1,2"}})
+	if err == nil {
+		t.Fatalf("expected error decoding a struct from text, got %v", value)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	type Thing struct {
+		Int   opt.T[int]
+		Bool  opt.T[bool]
+		Float opt.T[float32]
+		Uint  opt.T[uint16]
+	}
+
+	enc, dec := form.NewEncoder(), form.NewDecoder()
+	optform.Register(enc, dec)
+
+	err := quick.Check(func(ser Thing) bool {
+		var de Thing
+
+		values, err := enc.Encode(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = dec.Decode(&de, values)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if de != ser {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,83 @@
+// Package optgorm provides a serializer for options in [gorm.io/gorm].
+//
+// Options of types supported by [opt.T.Value] and [opt.T.Scan] need no serializer,
+// as options implement GormDataTypeInterface, see [opt.T.GormDataType],
+// while options of other values can use the "json" serializer of GORM,
+// which stores empty options as NULL, or the "opttext" serializer, see [Register].
+package optgorm
+
+import (
+	"context"
+	"encoding"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+)
+
+// presenter is implemented by options, see [opt.T.IsPresent].
+type presenter interface {
+	IsPresent() bool
+}
+
+// Register registers the [TextSerializer] as "opttext",
+// for use with the `gorm:"serializer:opttext"` tag.
+func Register() {
+	schema.RegisterSerializer("opttext", TextSerializer{})
+}
+
+// TextSerializer stores options as their text, or NULL if empty,
+// see [opt.T.MarshalText], e.g. for values like [net/netip.Addr],
+// which implement no [database/sql/driver.Valuer].
+//
+// Like [opt.T.UnmarshalText], empty text is scanned as empty option.
+type TextSerializer struct{}
+
+// Value implements [schema.SerializerValuerInterface].
+func (TextSerializer) Value(_ context.Context, field *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	if option, ok := fieldValue.(presenter); ok && !option.IsPresent() {
+		return nil, nil
+	}
+
+	marshaler, ok := fieldValue.(encoding.TextMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("optgorm: cannot marshal %s as text", field.FieldType)
+	}
+
+	text, err := marshaler.MarshalText()
+	if err != nil {
+		return nil, err
+	}
+
+	return string(text), nil
+}
+
+// Scan implements [schema.SerializerInterface].
+func (TextSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	value := reflect.New(field.FieldType)
+
+	unmarshaler, ok := value.Interface().(encoding.TextUnmarshaler)
+	if !ok {
+		return fmt.Errorf("optgorm: cannot unmarshal text into %s", field.FieldType)
+	}
+
+	var err error
+
+	switch text := dbValue.(type) {
+	case nil:
+	case []byte:
+		err = unmarshaler.UnmarshalText(text)
+	case string:
+		err = unmarshaler.UnmarshalText([]byte(text))
+	default:
+		return fmt.Errorf("optgorm: cannot scan %T as text", dbValue)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	field.ReflectValueOf(ctx, dst).Set(value.Elem())
+
+	return nil
+}
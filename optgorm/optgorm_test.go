@@ -0,0 +1,129 @@
+package optgorm_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optgorm"
+)
+
+func init() {
+	optgorm.Register()
+}
+
+type User struct {
+	ID       uint
+	Name     opt.T[string]
+	Age      opt.T[int]
+	Born     opt.T[time.Time]
+	Verified opt.Bool
+	Address  opt.T[netip.Addr] `gorm:"serializer:opttext"`
+	Tags     opt.T[[]string]   `gorm:"serializer:json"`
+}
+
+func open(t testing.TB) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = db.AutoMigrate(&User{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func Example() {
+	db, _ := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{Logger: logger.Discard})
+	_ = db.AutoMigrate(&User{})
+
+	db.Create(&User{
+		Name:    opt.Some("gopher"),
+		Address: opt.Some(netip.MustParseAddr("127.0.0.1")),
+	})
+
+	var user User
+
+	db.First(&user)
+	fmt.Println(user.Name, user.Age, user.Address, user.Tags)
+
+	var ddl string
+
+	db.Raw("SELECT sql FROM sqlite_master WHERE name = 'users'").Scan(&ddl)
+	fmt.Println(ddl)
+	// Output:
+	// Some[string](gopher) None[int]() Some[netip.Addr](127.0.0.1) None[[]string]()
+	// CREATE TABLE `users` (`id` integer PRIMARY KEY AUTOINCREMENT,`name` text,`age` integer,`born` datetime,`verified` numeric,`address` text,`tags` text)
+}
+
+func TestNull(t *testing.T) {
+	db := open(t)
+
+	err := db.Create(&User{}).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var nulls int64
+
+	err = db.Model(&User{}).
+		Where("name IS NULL AND age IS NULL AND born IS NULL AND verified IS NULL AND address IS NULL AND tags IS NULL").
+		Count(&nulls).Error
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if nulls != 1 {
+		t.Fatal("expected empty options to be stored as NULL")
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	db := open(t)
+
+	err := quick.Check(func(name opt.T[string], age opt.T[int], verified opt.Bool, address opt.T[[4]byte]) bool {
+		ser := User{
+			Name:     name,
+			Age:      age,
+			Verified: verified,
+			Address:  opt.Map(address, netip.AddrFrom4),
+		}
+
+		err := db.Create(&ser).Error
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		var de User
+
+		err = db.First(&de, ser.ID).Error
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/optini
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/lukasngl/opt v0.0.0
+	gopkg.in/ini.v1 v1.67.3
+)
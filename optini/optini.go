@@ -0,0 +1,239 @@
+// Package optini provides INI support for options using [gopkg.in/ini.v1].
+//
+// As ini.v1 maps fields by their kind without any extension point,
+// treating struct fields like options as sections,
+// values are converted to a mirrored struct type, in which options are replaced by
+// string pointers holding their text, see [opt.T.MarshalText], tagged with omitempty.
+// Thus empty options are absent keys, while present options are written.
+// Options nested in slices or maps are not supported.
+package optini
+
+import (
+	"encoding"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+
+	"gopkg.in/ini.v1"
+
+	"github.com/lukasngl/opt"
+)
+
+// textOption is implemented by pointers to options, see [opt.T.MarshalText].
+type textOption interface {
+	IsPresent() bool
+	encoding.TextMarshaler
+	encoding.TextUnmarshaler
+}
+
+var (
+	textOptionType = reflect.TypeOf((*textOption)(nil)).Elem()
+	stringPtrType  = reflect.TypeOf((*string)(nil))
+	mirrors        sync.Map // map[reflect.Type]reflect.Type
+)
+
+// MapTo maps the given section to the struct pointed to by v, like [ini.Section.MapTo].
+//
+// Absent keys leave options untouched, thus keeping defaults,
+// while present keys are decoded using [opt.T.UnmarshalText],
+// thus empty values are decoded as empty options.
+func MapTo(section *ini.Section, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("optini: not a pointer to a struct")
+	}
+
+	value, err := toMirror(rv.Elem())
+	if err != nil {
+		return err
+	}
+
+	mirrored := reflect.New(value.Type())
+	mirrored.Elem().Set(value)
+
+	err = section.MapTo(mirrored.Interface())
+	if err != nil {
+		return err
+	}
+
+	return fromMirror(mirrored.Elem(), rv.Elem())
+}
+
+// ReflectFrom reflects the given struct, or pointer to it, into the section,
+// like [ini.Section.ReflectFrom].
+//
+// Empty options are omitted, while present options are written using [opt.T.MarshalText].
+func ReflectFrom(section *ini.Section, v any) error {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return errors.New("optini: not a struct")
+	}
+
+	value, err := toMirror(rv)
+	if err != nil {
+		return err
+	}
+
+	// ini.v1 only reflects settable fields.
+	mirrored := reflect.New(value.Type())
+	mirrored.Elem().Set(value)
+
+	return section.ReflectFrom(mirrored.Interface())
+}
+
+// isOption reports, whether the type is an option, encoded as text.
+func isOption(t reflect.Type) bool {
+	_, ok := opt.ValueTypeOf(t)
+
+	return ok && reflect.PointerTo(t).Implements(textOptionType)
+}
+
+// mirror returns the type, in which options are replaced by string pointers,
+// or the type itself if it does not contain options.
+//
+// Structs with unexported fields, like [time.Time], are left as is.
+func mirror(t reflect.Type) reflect.Type {
+	if cached, ok := mirrors.Load(t); ok {
+		return cached.(reflect.Type)
+	}
+
+	mt := t
+
+	if t.Kind() == reflect.Struct && !isOption(t) && isExported(t) {
+		fields := make([]reflect.StructField, 0, t.NumField())
+		changed := false
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+
+			fieldType, fieldTag := mirror(field.Type), field.Tag
+			if isOption(field.Type) {
+				fieldType, fieldTag = stringPtrType, withOmitEmpty(field.Tag)
+			}
+
+			changed = changed || fieldType != field.Type
+
+			fields = append(fields, reflect.StructField{
+				Name: field.Name,
+				Type: fieldType,
+				Tag:  fieldTag,
+			})
+		}
+
+		if changed {
+			mt = reflect.StructOf(fields)
+		}
+	}
+
+	mirrors.Store(t, mt)
+
+	return mt
+}
+
+// isExported reports, whether all fields of the struct type are exported.
+func isExported(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// withOmitEmpty adds the omitempty option to the ini tag, unless the field is ignored.
+func withOmitEmpty(tag reflect.StructTag) reflect.StructTag {
+	value, ok := tag.Lookup("ini")
+	if !ok {
+		return `ini:",omitempty" ` + tag
+	}
+
+	if value == "-" || strings.Contains(value, ",omitempty") {
+		return tag
+	}
+
+	name, options, _ := strings.Cut(value, ",")
+	if options != "" {
+		options = "," + options
+	}
+
+	return reflect.StructTag(strings.Replace(string(tag),
+		"ini:"+strconv.Quote(value), "ini:"+strconv.Quote(name+",omitempty"+options), 1))
+}
+
+func toMirror(value reflect.Value) (reflect.Value, error) {
+	mt := mirror(value.Type())
+	if mt == value.Type() {
+		return value, nil
+	}
+
+	result := reflect.New(mt).Elem()
+
+	for i := 0; i < mt.NumField(); i++ {
+		field := value.Field(i)
+
+		if !isOption(field.Type()) {
+			inner, err := toMirror(field)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			result.Field(i).Set(inner)
+
+			continue
+		}
+
+		option := reflect.New(field.Type())
+		option.Elem().Set(field)
+
+		if !option.Interface().(textOption).IsPresent() {
+			continue
+		}
+
+		text, err := option.Interface().(textOption).MarshalText()
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Field(i).Set(reflect.ValueOf(new(string)))
+		result.Field(i).Elem().SetString(string(text))
+	}
+
+	return result, nil
+}
+
+func fromMirror(value, target reflect.Value) error {
+	if value.Type() == target.Type() {
+		target.Set(value)
+
+		return nil
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		field := target.Field(i)
+
+		if !isOption(field.Type()) {
+			err := fromMirror(value.Field(i), field)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		option := reflect.New(field.Type())
+
+		if !value.Field(i).IsNil() {
+			err := option.Interface().(textOption).UnmarshalText([]byte(value.Field(i).Elem().String()))
+			if err != nil {
+				return err
+			}
+		}
+
+		field.Set(option.Elem())
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package optini_test
+
+import (
+	"bytes"
+	"fmt"
+	"net/netip"
+	"os"
+	"testing"
+	"testing/quick"
+
+	"gopkg.in/ini.v1"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optini"
+)
+
+type Database struct {
+	Host string        `ini:"host"`
+	Port opt.T[uint16] `ini:"port"`
+}
+
+type Config struct {
+	Name     string            `ini:"name"`
+	Timeout  opt.T[int]        `ini:"timeout" comment:"in seconds"`
+	Debug    opt.Bool          `ini:"debug"`
+	Listen   opt.T[netip.Addr] `ini:"listen"`
+	Database Database          `ini:"database"`
+}
+
+func ExampleReflectFrom() {
+	cfg := ini.Empty()
+
+	_ = optini.ReflectFrom(cfg.Section(""), Config{
+		Name:     "legacy",
+		Debug:    opt.Some(false),
+		Listen:   opt.Some(netip.MustParseAddr("127.0.0.1")),
+		Database: Database{Host: "localhost"},
+	})
+
+	_, _ = cfg.WriteTo(os.Stdout)
+	// Output:
+	// name   = legacy
+	// debug  = false
+	// listen = 127.0.0.1
+	//
+	// [database]
+	// host = localhost
+}
+
+func ExampleMapTo() {
+	cfg, _ := ini.Load([]byte(`
+name    = legacy
+timeout = 30
+
+[database]
+host = localhost
+port = 5432
+`))
+
+	config := Config{Debug: opt.Some(true)}
+
+	_ = optini.MapTo(cfg.Section(""), &config)
+
+	fmt.Println(config.Timeout, config.Debug, config.Listen)
+	fmt.Println(config.Database.Port)
+	// Output:
+	// Some[int](30) Some[bool](true) None[netip.Addr]()
+	// Some[uint16](5432)
+}
+
+func TestMapToEmptyValue(t *testing.T) {
+	cfg, err := ini.Load([]byte("timeout =\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	config := Config{Timeout: opt.Some(30)}
+
+	err = optini.MapTo(cfg.Section(""), &config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if config.Timeout.IsPresent() {
+		t.Fatalf("expected empty value to decode as empty option, got %s", config.Timeout)
+	}
+}
+
+func TestMapToInvalid(t *testing.T) {
+	cfg, err := ini.Load([]byte("listen = nope\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = optini.MapTo(cfg.Section(""), &Config{})
+	if err == nil {
+		t.Fatal("expected error mapping an invalid address")
+	}
+}
+
+type Thing struct {
+	Bool    opt.Bool    `ini:"bool"`
+	Float64 opt.Float64 `ini:"float64"`
+	Int     opt.T[int]  `ini:"int"`
+	Uint16  opt.Uint16  `ini:"uint16"`
+	Ignored opt.T[int]  `ini:"-"`
+}
+
+func TestIdentity(t *testing.T) {
+	err := quick.Check(func(ser Thing) bool {
+		cfg := ini.Empty()
+
+		err := optini.ReflectFrom(cfg.Section(""), &ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		var buf bytes.Buffer
+
+		_, err = cfg.WriteTo(&buf)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		loaded, err := ini.Load(buf.Bytes())
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		de := Thing{Ignored: ser.Ignored}
+
+		err = optini.MapTo(loaded.Section(""), &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if de != ser {
+			t.Logf("ser: %#v", ser)
+			t.Logf("de: %#v", de)
+			t.Log(buf.String())
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,80 @@
+package opt
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Optional is implemented by pointers to options of any value type,
+// allowing reflection based integrations to detect and access options,
+// without knowing V at compile time.
+type Optional interface {
+	// ValueType returns the type of the value.
+	ValueType() reflect.Type
+	// GetAny returns the value and whether it is present.
+	GetAny() (any, bool)
+	// SetAny sets the value, or empties the option if the value is nil.
+	SetAny(value any) error
+}
+
+var optionalType = reflect.TypeOf((*Optional)(nil)).Elem()
+
+// Optional Reflection.
+var _ Optional = &T[any]{}
+
+// ValueTypeOf reports, whether t is an option, i.e. *t implements [Optional],
+// returning the type of its value.
+func ValueTypeOf(t reflect.Type) (reflect.Type, bool) {
+	if t == nil || !reflect.PointerTo(t).Implements(optionalType) {
+		return nil, false
+	}
+
+	return reflect.New(t).Interface().(Optional).ValueType(), true
+}
+
+// optionalValue returns the addressable value of the option and whether it is present.
+func optionalValue(option Optional) (reflect.Value, bool) {
+	value := reflect.New(option.ValueType()).Elem()
+
+	payload, present := option.GetAny()
+	if payload != nil {
+		value.Set(reflect.ValueOf(payload))
+	}
+
+	return value, present
+}
+
+// ValueType implements [Optional], returning the type of V.
+func (t *T[V]) ValueType() reflect.Type {
+	return reflect.TypeFor[V]()
+}
+
+// GetAny implements [Optional], returning the value and whether it is present.
+//
+// Empty options return nil.
+func (t *T[V]) GetAny() (any, bool) {
+	if !t.present {
+		return nil, false
+	}
+
+	return t.v, true
+}
+
+// SetAny implements [Optional], setting the option to the given value of V,
+// or empty if nil.
+func (t *T[V]) SetAny(value any) error {
+	if value == nil {
+		*t = None[V]()
+
+		return nil
+	}
+
+	v, ok := value.(V)
+	if !ok {
+		return fmt.Errorf("opt: cannot set %T as %s", value, reflect.TypeFor[V]())
+	}
+
+	*t = Some(v)
+
+	return nil
+}
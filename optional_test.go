@@ -0,0 +1,72 @@
+package opt_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleValueTypeOf() {
+	for _, value := range []any{opt.None[int](), opt.Bool{}, "gopher"} {
+		elem, ok := opt.ValueTypeOf(reflect.TypeOf(value))
+		fmt.Println(elem, ok)
+	}
+	// Output:
+	// int true
+	// bool true
+	// <nil> false
+}
+
+func ExampleOptional() {
+	var age opt.T[int]
+
+	option := reflect.ValueOf(&age).Interface().(opt.Optional)
+
+	_ = option.SetAny(42)
+	fmt.Println(age)
+	fmt.Println(option.GetAny())
+
+	_ = option.SetAny(nil)
+	fmt.Println(age)
+	fmt.Println(option.GetAny())
+	// Output:
+	// Some[int](42)
+	// 42 true
+	// None[int]()
+	// <nil> false
+}
+
+func TestSetAnyInvalid(t *testing.T) {
+	age := opt.Some(42)
+
+	err := age.SetAny("42")
+	if err == nil {
+		t.Fatal("expected error setting a string as int")
+	}
+
+	if !opt.Contains(age, 42) {
+		t.Fatalf("expected error to leave the option untouched, got %s", age)
+	}
+}
+
+func TestOptionalIdentity(t *testing.T) {
+	err := quick.Check(func(ser opt.T[string]) bool {
+		var de opt.T[string]
+
+		value, _ := ser.GetAny()
+
+		err := de.SetAny(value)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,111 @@
+// Package optjsoniter provides an extension for [github.com/json-iterator/go],
+// encoding options like encoding/json does, i.e. as null or their value.
+//
+// In contrast to encoding/json, empty options are also omitted by the omitempty tag option.
+package optjsoniter
+
+import (
+	"reflect"
+	"unsafe"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/modern-go/reflect2"
+
+	"github.com/lukasngl/opt"
+)
+
+// Extension is a [jsoniter.Extension] providing codecs for options.
+type Extension struct {
+	jsoniter.DummyExtension
+}
+
+// Register registers the extension with the given API,
+// or globally if none is given.
+func Register(apis ...jsoniter.API) {
+	if len(apis) == 0 {
+		jsoniter.RegisterExtension(&Extension{})
+
+		return
+	}
+
+	for _, api := range apis {
+		api.RegisterExtension(&Extension{})
+	}
+}
+
+// CreateEncoder implements [jsoniter.Extension].
+func (*Extension) CreateEncoder(typ reflect2.Type) jsoniter.ValEncoder {
+	elem, ok := opt.ValueTypeOf(typ.Type1())
+	if !ok {
+		return nil
+	}
+
+	return &codec{typ: typ, elem: elem}
+}
+
+// CreateDecoder implements [jsoniter.Extension].
+func (*Extension) CreateDecoder(typ reflect2.Type) jsoniter.ValDecoder {
+	elem, ok := opt.ValueTypeOf(typ.Type1())
+	if !ok {
+		return nil
+	}
+
+	return &codec{typ: typ, elem: elem}
+}
+
+type codec struct {
+	typ  reflect2.Type
+	elem reflect.Type
+}
+
+func (c *codec) option(ptr unsafe.Pointer) opt.Optional {
+	return c.typ.PackEFace(ptr).(opt.Optional)
+}
+
+func (c *codec) IsEmpty(ptr unsafe.Pointer) bool {
+	_, present := c.option(ptr).GetAny()
+
+	return !present
+}
+
+func (c *codec) Encode(ptr unsafe.Pointer, stream *jsoniter.Stream) {
+	value, present := c.option(ptr).GetAny()
+	if !present {
+		stream.WriteNil()
+
+		return
+	}
+
+	// write a pointer, to also use marshalers implemented by *V.
+	elem := reflect.New(c.elem)
+	if value != nil {
+		elem.Elem().Set(reflect.ValueOf(value))
+	}
+
+	stream.WriteVal(elem.Interface())
+}
+
+func (c *codec) Decode(ptr unsafe.Pointer, iter *jsoniter.Iterator) {
+	option := c.option(ptr)
+
+	if iter.WhatIsNext() == jsoniter.NilValue {
+		iter.ReadNil()
+
+		_ = option.SetAny(nil)
+
+		return
+	}
+
+	value := reflect.New(c.elem)
+
+	iter.ReadVal(value.Interface())
+
+	if iter.Error != nil {
+		return
+	}
+
+	err := option.SetAny(value.Elem().Interface())
+	if err != nil {
+		iter.ReportError("optjsoniter", err.Error())
+	}
+}
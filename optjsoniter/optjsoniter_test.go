@@ -0,0 +1,109 @@
+package optjsoniter_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	jsoniter "github.com/json-iterator/go"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optjsoniter"
+)
+
+var api = jsoniter.Config{SortMapKeys: true}.Froze()
+
+func init() {
+	optjsoniter.Register(api)
+}
+
+type User struct {
+	Name     opt.T[string]         `json:"name"`
+	Email    opt.T[string]         `json:"email"`
+	Nickname opt.T[string]         `json:"nickname,omitempty"`
+	Labels   opt.T[map[string]int] `json:"labels"`
+}
+
+func ExampleRegister() {
+	data, _ := api.Marshal(User{
+		Name:   opt.Some("gopher"),
+		Labels: opt.Some(map[string]int{"b": 2, "a": 1}),
+	})
+
+	fmt.Println(string(data))
+
+	var user User
+
+	_ = api.Unmarshal([]byte(`{"name":null,"email":"gopher@go.dev"}`), &user)
+
+	fmt.Println(user.Name, user.Email, user.Nickname)
+	// Output:
+	// {"name":"gopher","email":null,"labels":{"a":1,"b":2}}
+	// None[string]() Some[string](gopher@go.dev) None[string]()
+}
+
+func TestInvalid(t *testing.T) {
+	var user User
+
+	err := api.Unmarshal([]byte(`{"name":42}`), &user)
+	if err == nil {
+		t.Fatalf("expected error decoding a number into a string, got %v", user)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	type Thing struct {
+		Int    opt.T[int]         `json:"int"`
+		String opt.T[string]      `json:"string"`
+		Floats opt.T[[]float64]   `json:"floats"`
+		Nested opt.T[opt.T[bool]] `json:"nested"`
+	}
+
+	err := quick.Check(func(i opt.T[int], s opt.T[string], f opt.T[[]float64], n opt.T[bool]) bool {
+		var de Thing
+
+		ser := Thing{Int: i, String: s, Floats: f, Nested: opt.Some(n)}
+
+		data, err := api.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		std, err := json.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if string(data) != string(std) {
+			t.Logf("jsoniter: %s", data)
+			t.Logf("encoding/json: %s", std)
+
+			return false
+		}
+
+		err = api.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		// a nested empty option is indistinguishable from an empty option
+		if !n.IsPresent() {
+			ser.Nested = opt.None[opt.T[bool]]()
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
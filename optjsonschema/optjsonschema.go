@@ -0,0 +1,100 @@
+// Package optjsonschema provides JSON Schema support for options
+// using [github.com/invopop/jsonschema].
+//
+// Without it, options are described by their internal struct, which has no
+// exported fields, instead of the value they are encoded as.
+// Options are described as their value or null, either by using [T],
+// or by installing [Mapper] on the reflector for plain options.
+package optjsonschema
+
+import (
+	"reflect"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/lukasngl/opt"
+)
+
+// T is an option, that is described as the schema of its value or null.
+type T[V any] struct {
+	opt.T[V]
+}
+
+// From wraps the given option.
+func From[V any](t opt.T[V]) T[V] {
+	return T[V]{T: t}
+}
+
+// Some returns a present option of the given value.
+func Some[V any](v V) T[V] {
+	return From(opt.Some(v))
+}
+
+// None returns an empty option.
+func None[V any]() T[V] {
+	return From(opt.None[V]())
+}
+
+// JSONSchema implements the custom schema hook of [jsonschema.Reflector],
+// see [Schema].
+func (T[V]) JSONSchema() *jsonschema.Schema {
+	return Schema[V](nil)
+}
+
+// Schema returns the schema of an option of V, i.e. the schema of V or null.
+//
+// The schema of V is reflected inline using the given reflector,
+// or the default one if nil.
+func Schema[V any](r *jsonschema.Reflector) *jsonschema.Schema {
+	return schemaOf(r, reflect.TypeOf((*V)(nil)).Elem())
+}
+
+// Nullable returns a schema, that accepts null or anything the given schema accepts.
+func Nullable(schema *jsonschema.Schema) *jsonschema.Schema {
+	//nolint:exhaustruct
+	return &jsonschema.Schema{
+		OneOf: []*jsonschema.Schema{
+			schema,
+			{Type: "null"},
+		},
+	}
+}
+
+// Mapper returns a function for [jsonschema.Reflector.Mapper],
+// describing plain options like [T], and deferring to the reflector's
+// previous mapper for other types.
+//
+// Install it after configuring the reflector, as the schemas of values are
+// reflected using a copy of it.
+func Mapper(r *jsonschema.Reflector) func(reflect.Type) *jsonschema.Schema {
+	next := r.Mapper
+
+	return func(t reflect.Type) *jsonschema.Schema {
+		if elem, ok := opt.ValueTypeOf(t); ok {
+			return schemaOf(r, elem)
+		}
+
+		if next == nil {
+			return nil
+		}
+
+		return next(t)
+	}
+}
+
+func schemaOf(r *jsonschema.Reflector, t reflect.Type) *jsonschema.Schema {
+	//nolint:exhaustruct
+	inner := jsonschema.Reflector{}
+	if r != nil {
+		inner = *r
+	}
+
+	inner.DoNotReference = true
+	inner.Anonymous = true
+
+	schema := inner.ReflectFromType(t)
+	schema.Version = ""
+	schema.ID = ""
+
+	return Nullable(schema)
+}
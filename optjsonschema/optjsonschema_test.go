@@ -0,0 +1,133 @@
+package optjsonschema_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/invopop/jsonschema"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optjsonschema"
+)
+
+type Address struct {
+	City string `json:"city"`
+}
+
+type User struct {
+	Name    opt.T[string]  `json:"name"`
+	Age     opt.T[int]     `json:"age,omitzero"`
+	Address opt.T[Address] `json:"address,omitempty"`
+}
+
+func ExampleMapper() {
+	//nolint:exhaustruct
+	reflector := &jsonschema.Reflector{DoNotReference: true}
+	reflector.Mapper = optjsonschema.Mapper(reflector)
+
+	schema := reflector.Reflect(User{})
+
+	for _, name := range []string{"name", "age", "address"} {
+		property, _ := schema.Properties.Get(name)
+		data, _ := json.Marshal(property)
+		fmt.Println(string(data))
+	}
+
+	fmt.Println(schema.Required)
+	// Output:
+	// {"oneOf":[{"type":"string"},{"type":"null"}]}
+	// {"oneOf":[{"type":"integer"},{"type":"null"}]}
+	// {"oneOf":[{"properties":{"city":{"type":"string"}},"additionalProperties":false,"type":"object","required":["city"]},{"type":"null"}]}
+	// [name]
+}
+
+type Query struct {
+	Limit optjsonschema.T[int] `json:"limit,omitzero"`
+}
+
+func ExampleT_JSONSchema() {
+	//nolint:exhaustruct
+	reflector := &jsonschema.Reflector{DoNotReference: true}
+
+	schema := reflector.Reflect(Query{})
+	property, _ := schema.Properties.Get("limit")
+	data, _ := json.Marshal(property)
+
+	fmt.Println(string(data))
+	// Output: {"oneOf":[{"type":"integer"},{"type":"null"}]}
+}
+
+func ExampleSchema() {
+	data, _ := json.Marshal(optjsonschema.Schema[[]string](nil))
+
+	fmt.Println(string(data))
+	// Output: {"oneOf":[{"items":{"type":"string"},"type":"array"},{"type":"null"}]}
+}
+
+type Celsius float64
+
+func TestMapperDefersToPreviousMapper(t *testing.T) {
+	t.Parallel()
+
+	//nolint:exhaustruct
+	reflector := &jsonschema.Reflector{
+		DoNotReference: true,
+		Mapper: func(t reflect.Type) *jsonschema.Schema {
+			if t != reflect.TypeOf(Celsius(0)) {
+				return nil
+			}
+
+			//nolint:exhaustruct
+			return &jsonschema.Schema{Type: "number", Title: "celsius"}
+		},
+	}
+	reflector.Mapper = optjsonschema.Mapper(reflector)
+
+	type Reading struct {
+		Value opt.T[Celsius] `json:"value"`
+		Plain Celsius        `json:"plain"`
+	}
+
+	schema := reflector.Reflect(Reading{})
+
+	for _, name := range []string{"value", "plain"} {
+		property, _ := schema.Properties.Get(name)
+		if property == nil {
+			t.Fatalf("missing property %s", name)
+		}
+
+		if name == "value" {
+			property = property.OneOf[0]
+		}
+
+		if property.Title != "celsius" {
+			t.Errorf("expected %s to be described by the previous mapper, got %+v", name, property)
+		}
+	}
+}
+
+func TestTMarshalsLikeOption(t *testing.T) {
+	t.Parallel()
+
+	data, err := json.Marshal(Query{Limit: optjsonschema.Some(10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"limit":10}` {
+		t.Errorf("unexpected json: %s", data)
+	}
+
+	var query Query
+
+	err = json.Unmarshal([]byte(`{"limit":null}`), &query)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if query.Limit.IsPresent() {
+		t.Errorf("expected empty option, got %v", query.Limit)
+	}
+}
@@ -0,0 +1,12 @@
+module github.com/lukasngl/opt/optmsgpack
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/lukasngl/opt v0.0.0
+	github.com/vmihailenco/msgpack/v5 v5.4.1
+)
+
+require github.com/vmihailenco/tagparser/v2 v2.0.0 // indirect
@@ -0,0 +1,73 @@
+// Package optmsgpack provides MessagePack support for options
+// using [github.com/vmihailenco/msgpack/v5].
+package optmsgpack
+
+import (
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/vmihailenco/msgpack/v5/msgpcode"
+
+	"github.com/lukasngl/opt"
+)
+
+// MessagePack Marshalling und Unmarshalling.
+var (
+	_ msgpack.CustomEncoder = T[any]{}
+	_ msgpack.CustomDecoder = &T[any]{}
+)
+
+// T is an option, that is encoded as nil if empty, or otherwise as its value.
+//
+// Empty options can be omitted using the omitempty tag option.
+type T[V any] struct {
+	opt.T[V]
+}
+
+// From wraps the given option.
+func From[V any](t opt.T[V]) T[V] {
+	return T[V]{T: t}
+}
+
+// Some returns a present option of the given value.
+func Some[V any](v V) T[V] {
+	return From(opt.Some(v))
+}
+
+// None returns an empty option.
+func None[V any]() T[V] {
+	return From(opt.None[V]())
+}
+
+// EncodeMsgpack implements [msgpack.CustomEncoder].
+func (t T[V]) EncodeMsgpack(enc *msgpack.Encoder) error {
+	value, present := t.Unwrap()
+	if !present {
+		return enc.EncodeNil()
+	}
+
+	return enc.Encode(value)
+}
+
+// DecodeMsgpack implements [msgpack.CustomDecoder].
+func (t *T[V]) DecodeMsgpack(dec *msgpack.Decoder) error {
+	code, err := dec.PeekCode()
+	if err != nil {
+		return err
+	}
+
+	if code == msgpcode.Nil {
+		*t = None[V]()
+
+		return dec.DecodeNil()
+	}
+
+	var value V
+
+	err = dec.Decode(&value)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
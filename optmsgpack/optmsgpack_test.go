@@ -0,0 +1,76 @@
+package optmsgpack_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/vmihailenco/msgpack/v5"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optmsgpack"
+)
+
+type Request struct {
+	Method optmsgpack.T[string] `msgpack:"method"`
+	Params optmsgpack.T[[]int]  `msgpack:"params"`
+	Trace  optmsgpack.T[string] `msgpack:"trace,omitempty"`
+}
+
+func ExampleT_EncodeMsgpack() {
+	data, _ := msgpack.Marshal(Request{
+		Method: optmsgpack.Some("sum"),
+		Params: optmsgpack.None[[]int](),
+		Trace:  optmsgpack.None[string](),
+	})
+
+	fmt.Printf("%x\n", data)
+	// Output: 82a66d6574686f64a373756da6706172616d73c0
+}
+
+func ExampleT_DecodeMsgpack() {
+	var request Request
+
+	_ = msgpack.Unmarshal([]byte("\x82\xa6method\xa3sum\xa6params\xc0"), &request)
+
+	fmt.Println(request.Method, request.Params, request.Trace)
+	// Output: Some[string](sum) None[[]int]() None[string]()
+}
+
+func TestIdentity(t *testing.T) {
+	type Thing struct {
+		Int    optmsgpack.T[int]            `msgpack:"int,omitempty"`
+		String optmsgpack.T[string]         `msgpack:"string"`
+		Bytes  optmsgpack.T[[]byte]         `msgpack:"bytes"`
+		Map    optmsgpack.T[map[string]int] `msgpack:"map"`
+	}
+
+	err := quick.Check(func(i opt.T[int], s opt.T[string], b opt.T[[]byte], m opt.T[map[string]int]) bool {
+		var de Thing
+
+		ser := Thing{Int: optmsgpack.From(i), String: optmsgpack.From(s), Bytes: optmsgpack.From(b), Map: optmsgpack.From(m)}
+
+		data, err := msgpack.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = msgpack.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
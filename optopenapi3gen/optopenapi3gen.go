@@ -0,0 +1,52 @@
+// Package optopenapi3gen provides a schema customizer for
+// [github.com/getkin/kin-openapi/openapi3gen],
+// describing options as the schema of their value, marked as nullable.
+//
+// Without it, options are described by their internal struct,
+// i.e. as an object without properties.
+package optopenapi3gen
+
+import (
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+
+	"github.com/lukasngl/opt"
+)
+
+// SchemaCustomizer returns a [openapi3gen.SchemaCustomizerFn] describing options
+// as the schema of their value, marked as nullable.
+//
+// The given customizers are applied afterwards to all schemas, including options,
+// as a generator only accepts a single customizer.
+func SchemaCustomizer(customizers ...openapi3gen.SchemaCustomizerFn) openapi3gen.SchemaCustomizerFn {
+	var customizer openapi3gen.SchemaCustomizerFn
+
+	customizer = func(name string, t reflect.Type, tag reflect.StructTag, schema *openapi3.Schema) error {
+		if elem, ok := opt.ValueTypeOf(t); ok {
+			ref, err := openapi3gen.NewSchemaRefForValue(
+				reflect.New(elem).Interface(),
+				nil,
+				openapi3gen.SchemaCustomizer(customizer),
+			)
+			if err != nil {
+				return err
+			}
+
+			*schema = *ref.Value
+			schema.Nullable = true
+		}
+
+		for _, next := range customizers {
+			err := next(name, t, tag, schema)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	return customizer
+}
@@ -0,0 +1,106 @@
+package optopenapi3gen_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3gen"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optopenapi3gen"
+)
+
+type Address struct {
+	City   string        `json:"city"`
+	Street opt.T[string] `json:"street"`
+}
+
+type User struct {
+	Name    string         `json:"name"`
+	Age     opt.T[int64]   `json:"age,omitzero"`
+	Address opt.T[Address] `json:"address"`
+}
+
+func ExampleSchemaCustomizer() {
+	ref, _ := openapi3gen.NewSchemaRefForValue(
+		User{},
+		nil,
+		openapi3gen.SchemaCustomizer(optopenapi3gen.SchemaCustomizer()),
+	)
+
+	for _, name := range []string{"name", "age", "address"} {
+		data, _ := json.Marshal(ref.Value.Properties[name])
+		fmt.Println(string(data))
+	}
+	// Output:
+	// {"type":"string"}
+	// {"format":"int64","nullable":true,"type":"integer"}
+	// {"nullable":true,"properties":{"city":{"type":"string"},"street":{"nullable":true,"type":"string"}},"type":"object"}
+}
+
+func TestSchemaCustomizerAppliesCustomizers(t *testing.T) {
+	t.Parallel()
+
+	describe := func(name string, _ reflect.Type, tag reflect.StructTag, schema *openapi3.Schema) error {
+		schema.Description = tag.Get("description")
+
+		return nil
+	}
+
+	type Query struct {
+		Limit opt.T[int] `json:"limit" description:"maximum number of results"`
+		Query string     `json:"query" description:"search terms"`
+	}
+
+	ref, err := openapi3gen.NewSchemaRefForValue(
+		Query{},
+		nil,
+		openapi3gen.SchemaCustomizer(optopenapi3gen.SchemaCustomizer(describe)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limit := ref.Value.Properties["limit"].Value
+	if !limit.Nullable || !limit.Type.Is("integer") || limit.Description != "maximum number of results" {
+		t.Errorf("unexpected schema for limit: %+v", limit)
+	}
+
+	query := ref.Value.Properties["query"].Value
+	if query.Nullable || query.Description != "search terms" {
+		t.Errorf("unexpected schema for query: %+v", query)
+	}
+}
+
+func TestSchemaCustomizerPropagatesErrors(t *testing.T) {
+	t.Parallel()
+
+	exclude := func(name string, _ reflect.Type, _ reflect.StructTag, _ *openapi3.Schema) error {
+		if name == "secret" {
+			return &openapi3gen.ExcludeSchemaSentinel{}
+		}
+
+		return nil
+	}
+
+	type Credentials struct {
+		User   string        `json:"user"`
+		Secret opt.T[string] `json:"secret"`
+	}
+
+	ref, err := openapi3gen.NewSchemaRefForValue(
+		Credentials{},
+		nil,
+		openapi3gen.SchemaCustomizer(optopenapi3gen.SchemaCustomizer(exclude)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := ref.Value.Properties["secret"]; ok {
+		t.Errorf("expected secret to be excluded, got %+v", ref.Value.Properties)
+	}
+}
@@ -0,0 +1,221 @@
+// Package optparquet provides parquet support for options using [github.com/parquet-go/parquet-go].
+//
+// As parquet-go derives schemas from struct fields without any extension point,
+// rows are converted to a mirrored struct type, in which options are replaced by pointers,
+// thus mapping them to OPTIONAL columns.
+// Options nested in slices or maps are not supported.
+package optparquet
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/parquet-go/parquet-go"
+
+	"github.com/lukasngl/opt"
+)
+
+var mirrors sync.Map // map[reflect.Type]reflect.Type
+
+// SchemaOf returns the parquet schema of the given model,
+// in which options are mapped to OPTIONAL columns.
+func SchemaOf(model any) *parquet.Schema {
+	return parquet.SchemaOf(reflect.New(mirror(reflect.TypeOf(model))).Interface())
+}
+
+// Write writes the given rows to a parquet file written to w.
+func Write[M any](w io.Writer, rows []M, options ...parquet.WriterOption) error {
+	var model M
+
+	schema := SchemaOf(model)
+	writer := parquet.NewWriter(w, append([]parquet.WriterOption{schema}, options...)...)
+	mt := mirror(reflect.TypeOf(model))
+
+	for _, row := range rows {
+		value, err := toMirror(reflect.ValueOf(row), mt)
+		if err != nil {
+			return err
+		}
+
+		err = writer.Write(value.Interface())
+		if err != nil {
+			return err
+		}
+	}
+
+	return writer.Close()
+}
+
+// Read reads all rows of the parquet file in the given reader.
+func Read[M any](r io.ReaderAt, size int64, options ...parquet.ReaderOption) ([]M, error) {
+	var model M
+
+	file, err := parquet.OpenFile(r, size)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := parquet.NewReader(file, append([]parquet.ReaderOption{SchemaOf(model)}, options...)...)
+	defer reader.Close()
+
+	mt := mirror(reflect.TypeOf(model))
+	rows := make([]M, 0, reader.NumRows())
+
+	for {
+		value := reflect.New(mt)
+
+		err := reader.Read(value.Interface())
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		row, err := fromMirror(value.Elem(), reflect.TypeOf(model))
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, row.Interface().(M))
+	}
+}
+
+// mirror returns the type, in which options are replaced by pointers,
+// or the type itself if it does not contain options.
+// Structs with unexported fields, like [time.Time], are handled by parquet-go itself.
+func mirror(t reflect.Type) reflect.Type {
+	if cached, ok := mirrors.Load(t); ok {
+		return cached.(reflect.Type)
+	}
+
+	mt := t
+
+	if elem, ok := opt.ValueTypeOf(t); ok {
+		mt = reflect.PointerTo(mirror(elem))
+	} else if t.Kind() == reflect.Struct && isExported(t) {
+		fields := make([]reflect.StructField, 0, t.NumField())
+		changed := false
+
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			fieldType := mirror(field.Type)
+			changed = changed || fieldType != field.Type
+
+			fields = append(fields, reflect.StructField{
+				Name: field.Name,
+				Type: fieldType,
+				Tag:  field.Tag,
+			})
+		}
+
+		if changed {
+			mt = reflect.StructOf(fields)
+		}
+	}
+
+	mirrors.Store(t, mt)
+
+	return mt
+}
+
+// isExported reports, whether all fields of the struct type are exported.
+func isExported(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if !t.Field(i).IsExported() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func toMirror(value reflect.Value, mt reflect.Type) (reflect.Value, error) {
+	if value.Type() == mt {
+		return value, nil
+	}
+
+	if elemType, ok := opt.ValueTypeOf(value.Type()); ok {
+		option := reflect.New(value.Type())
+		option.Elem().Set(value)
+
+		payload, present := option.Interface().(opt.Optional).GetAny()
+		if !present {
+			return reflect.Zero(mt), nil
+		}
+
+		inner := reflect.New(elemType).Elem()
+		if payload != nil {
+			inner.Set(reflect.ValueOf(payload))
+		}
+
+		elem, err := toMirror(inner, mt.Elem())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result := reflect.New(mt.Elem())
+		result.Elem().Set(elem)
+
+		return result, nil
+	}
+
+	result := reflect.New(mt).Elem()
+
+	for i := 0; i < mt.NumField(); i++ {
+		field, err := toMirror(value.FieldByName(mt.Field(i).Name), mt.Field(i).Type)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		result.Field(i).Set(field)
+	}
+
+	return result, nil
+}
+
+func fromMirror(value reflect.Value, t reflect.Type) (reflect.Value, error) {
+	if value.Type() == t {
+		return value, nil
+	}
+
+	if elem, ok := opt.ValueTypeOf(t); ok {
+		var payload any
+
+		if !value.IsNil() {
+			inner, err := fromMirror(value.Elem(), elem)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			payload = inner.Interface()
+		}
+
+		result := reflect.New(t)
+
+		err := result.Interface().(opt.Optional).SetAny(payload)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return result.Elem(), nil
+	}
+
+	result := reflect.New(t).Elem()
+
+	for i := 0; i < value.NumField(); i++ {
+		field := result.FieldByName(value.Type().Field(i).Name)
+
+		inner, err := fromMirror(value.Field(i), field.Type())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		field.Set(inner)
+	}
+
+	return result, nil
+}
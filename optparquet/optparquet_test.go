@@ -0,0 +1,123 @@
+package optparquet_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optparquet"
+)
+
+type Address struct {
+	City string        `parquet:"city"`
+	Zip  opt.T[string] `parquet:"zip"`
+}
+
+type Customer struct {
+	ID      int64          `parquet:"id"`
+	Name    opt.T[string]  `parquet:"name"`
+	Age     opt.T[int32]   `parquet:"age"`
+	Address opt.T[Address] `parquet:"address"`
+}
+
+func ExampleSchemaOf() {
+	fmt.Print(optparquet.SchemaOf(Customer{}))
+	// Output:
+	// message {
+	// 	required int64 id (INT(64,true));
+	// 	optional binary name (STRING);
+	// 	optional int32 age (INT(32,true));
+	// 	optional group address {
+	// 		required binary city (STRING);
+	// 		optional binary zip (STRING);
+	// 	}
+	// }
+}
+
+func ExampleRead() {
+	var buf bytes.Buffer
+
+	_ = optparquet.Write(&buf, []Customer{
+		{ID: 1, Name: opt.Some("gopher"), Address: opt.Some(Address{City: "Berlin"})},
+		{ID: 2, Age: opt.Some[int32](0)},
+	})
+
+	customers, _ := optparquet.Read[Customer](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+
+	for _, customer := range customers {
+		fmt.Println(customer.ID, customer.Name, customer.Age, customer.Address.IsPresent())
+	}
+	// Output:
+	// 1 Some[string](gopher) None[int32]() true
+	// 2 None[string]() Some[int32](0) false
+}
+
+func TestIdentity(t *testing.T) {
+	err := quick.Check(func(ser []Customer) bool {
+		var buf bytes.Buffer
+
+		err := optparquet.Write(&buf, ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		de, err := optparquet.Read[Customer](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser) || len(de) == 0 && len(ser) == 0
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+type Visit struct {
+	At   time.Time        `parquet:"at"`
+	Left opt.T[time.Time] `parquet:"left"`
+}
+
+func TestTime(t *testing.T) {
+	at := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	ser := []Visit{{At: at, Left: opt.Some(at.Add(time.Hour))}, {At: at}}
+
+	var buf bytes.Buffer
+
+	err := optparquet.Write(&buf, ser)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	de, err := optparquet.Read[Visit](bytes.NewReader(buf.Bytes()), int64(buf.Len()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(de) != len(ser) {
+		t.Fatalf("expected %d rows, got %d", len(ser), len(de))
+	}
+
+	for i := range ser {
+		if !de[i].At.Equal(ser[i].At) || !opt.EqualFunc(de[i].Left, ser[i].Left, time.Time.Equal) {
+			t.Fatalf("expected %v, got %v", ser[i], de[i])
+		}
+	}
+
+	schema := optparquet.SchemaOf(Visit{}).String()
+	if strings.Contains(schema, "group") {
+		t.Fatalf("expected time columns instead of groups, got %s", schema)
+	}
+}
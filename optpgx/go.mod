@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/optpgx
+
+go 1.25.0
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/jackc/pgx/v5 v5.11.0
+	github.com/lukasngl/opt v0.0.0
+)
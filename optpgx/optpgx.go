@@ -0,0 +1,158 @@
+// Package optpgx provides native support for options in [github.com/jackc/pgx/v5],
+// encoding and scanning their values using the plans of pgx, e.g. in the binary format,
+// instead of falling back to [opt.T.Value] and [opt.T.Scan].
+//
+// As pgx prefers [database/sql.Scanner] over wrapping scan targets,
+// the codecs of registered types are wrapped, see [Register].
+package optpgx
+
+import (
+	"reflect"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/lukasngl/opt"
+)
+
+// firstNormalObjectID is the first OID assigned to user-defined objects,
+// thus all builtin types have lower OIDs.
+const firstNormalObjectID = 16384
+
+// Register installs plans for options into the given map,
+// e.g. the TypeMap of a connection in its AfterConnect hook.
+//
+// Empty options are encoded as NULL and NULL is scanned as empty option,
+// otherwise the plans of the value are used.
+//
+// The builtin types of the map are wrapped using [WrapType],
+// types registered afterwards, like enums loaded by [github.com/jackc/pgx/v5.Conn.LoadType],
+// must be wrapped explicitly to scan options of them.
+func Register(m *pgtype.Map) {
+	m.TryWrapEncodePlanFuncs = append([]pgtype.TryWrapEncodePlanFunc{tryWrapEncodePlan}, m.TryWrapEncodePlanFuncs...)
+
+	for oid := uint32(0); oid < firstNormalObjectID; oid++ {
+		if t, ok := m.TypeForOID(oid); ok {
+			m.RegisterType(WrapType(t))
+		}
+	}
+}
+
+// WrapType returns a copy of the type, whose codec encodes and scans options
+// before delegating to the codec of the given type.
+func WrapType(t *pgtype.Type) *pgtype.Type {
+	if _, ok := t.Codec.(codec); ok {
+		return t
+	}
+
+	return &pgtype.Type{
+		Codec: codec{Codec: t.Codec},
+		Name:  t.Name,
+		OID:   t.OID,
+	}
+}
+
+// codec plans options before delegating to the wrapped codec.
+type codec struct {
+	pgtype.Codec
+}
+
+// PlanEncode implements [pgtype.Codec].
+func (c codec) PlanEncode(m *pgtype.Map, oid uint32, format int16, value any) pgtype.EncodePlan {
+	plan, next, ok := tryWrapEncodePlan(value)
+	if !ok {
+		return c.Codec.PlanEncode(m, oid, format, value)
+	}
+
+	nextPlan := m.PlanEncode(oid, format, next)
+	if nextPlan == nil {
+		return nil
+	}
+
+	plan.SetNext(nextPlan)
+
+	return plan
+}
+
+// PlanScan implements [pgtype.Codec].
+func (c codec) PlanScan(m *pgtype.Map, oid uint32, format int16, target any) pgtype.ScanPlan {
+	plan, next, ok := tryWrapScanPlan(target)
+	if !ok {
+		return c.Codec.PlanScan(m, oid, format, target)
+	}
+
+	plan.SetNext(m.PlanScan(oid, format, next))
+
+	return plan
+}
+
+// tryWrapEncodePlan implements [pgtype.TryWrapEncodePlanFunc] for options,
+// continuing with the type of their value.
+func tryWrapEncodePlan(value any) (pgtype.WrappedEncodePlanNextSetter, any, bool) {
+	elem, ok := opt.ValueTypeOf(reflect.TypeOf(value))
+	if !ok {
+		return nil, nil, false
+	}
+
+	return &encodePlan{}, reflect.Zero(elem).Interface(), true
+}
+
+type encodePlan struct {
+	next pgtype.EncodePlan
+}
+
+func (p *encodePlan) SetNext(next pgtype.EncodePlan) { p.next = next }
+
+// Encode implements [pgtype.EncodePlan], encoding empty options as NULL.
+func (p *encodePlan) Encode(value any, buf []byte) ([]byte, error) {
+	option := reflect.New(reflect.TypeOf(value))
+	option.Elem().Set(reflect.ValueOf(value))
+
+	payload, present := option.Interface().(opt.Optional).GetAny()
+	if !present {
+		return nil, nil
+	}
+
+	return p.next.Encode(payload, buf)
+}
+
+// tryWrapScanPlan is like [pgtype.TryWrapScanPlanFunc] for pointers to options,
+// continuing with a pointer to their value.
+func tryWrapScanPlan(target any) (pgtype.WrappedScanPlanNextSetter, any, bool) {
+	t := reflect.TypeOf(target)
+	if t == nil || t.Kind() != reflect.Pointer {
+		return nil, nil, false
+	}
+
+	elem, ok := opt.ValueTypeOf(t.Elem())
+	if !ok {
+		return nil, nil, false
+	}
+
+	return &scanPlan{elem: elem}, reflect.New(elem).Interface(), true
+}
+
+type scanPlan struct {
+	elem reflect.Type
+	next pgtype.ScanPlan
+}
+
+func (p *scanPlan) SetNext(next pgtype.ScanPlan) { p.next = next }
+
+// Scan implements [pgtype.ScanPlan], scanning NULL as empty option,
+// otherwise scanning into a fresh value, thus errors leave the option untouched.
+func (p *scanPlan) Scan(src []byte, dst any) error {
+	option := dst.(opt.Optional)
+
+	if src == nil {
+		return option.SetAny(nil)
+	}
+
+	value := reflect.New(p.elem)
+
+	err := p.next.Scan(src, value.Interface())
+	if err != nil {
+		return err
+	}
+
+	return option.SetAny(value.Elem().Interface())
+}
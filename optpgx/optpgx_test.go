@@ -0,0 +1,125 @@
+package optpgx_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+	"testing/quick"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optpgx"
+)
+
+func ExampleRegister() {
+	m := pgtype.NewMap()
+	optpgx.Register(m)
+
+	data, _ := m.Encode(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, opt.Some([]int32{1, 2}), nil)
+	null, _ := m.Encode(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, opt.None[[]int32](), nil)
+
+	var numbers opt.T[[]int32]
+
+	_ = m.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, data, &numbers)
+	fmt.Println(numbers, null == nil)
+
+	_ = m.Scan(pgtype.Int4ArrayOID, pgtype.BinaryFormatCode, nil, &numbers)
+	fmt.Println(numbers)
+	// Output: Some[[]int32]([1 2]) true
+	// None[[]int32]()
+}
+
+type Mood string
+
+func ExampleWrapType() {
+	m := pgtype.NewMap()
+	optpgx.Register(m)
+
+	// e.g. loaded by pgx.Conn.LoadType
+	m.RegisterType(optpgx.WrapType(&pgtype.Type{Name: "mood", OID: 16385, Codec: &pgtype.EnumCodec{}}))
+
+	var mood opt.T[Mood]
+
+	_ = m.Scan(16385, pgtype.TextFormatCode, []byte("happy"), &mood)
+	fmt.Println(mood)
+	// Output: Some[optpgx_test.Mood](happy)
+}
+
+func TestEncodeJSONNull(t *testing.T) {
+	m := pgtype.NewMap()
+	optpgx.Register(m)
+
+	data, err := m.Encode(pgtype.JSONBOID, pgtype.BinaryFormatCode, opt.None[map[string]int](), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data != nil {
+		t.Fatalf("expected empty option to be encoded as NULL instead of JSON null, got %q", data)
+	}
+}
+
+func TestScanError(t *testing.T) {
+	m := pgtype.NewMap()
+	optpgx.Register(m)
+
+	number := opt.Some[int32](42)
+
+	err := m.Scan(pgtype.Int4OID, pgtype.BinaryFormatCode, []byte{1}, &number)
+	if err == nil {
+		t.Fatal("expected error scanning a malformed int4")
+	}
+
+	if !opt.Contains(number, 42) {
+		t.Fatalf("expected error to leave the option untouched, got %s", number)
+	}
+}
+
+func TestIdentity(t *testing.T) {
+	m := pgtype.NewMap()
+	optpgx.Register(m)
+
+	for _, format := range []int16{pgtype.BinaryFormatCode, pgtype.TextFormatCode} {
+		err := quick.Check(func(ser opt.T[int64], numbers opt.T[[]int32]) bool {
+			var de opt.T[int64]
+
+			data, err := m.Encode(pgtype.Int8OID, format, ser, nil)
+			if err != nil {
+				t.Log(err.Error())
+				return false
+			}
+
+			err = m.Scan(pgtype.Int8OID, format, data, &de)
+			if err != nil {
+				t.Log(err.Error())
+				return false
+			}
+
+			var deNumbers opt.T[[]int32]
+
+			data, err = m.Encode(pgtype.Int4ArrayOID, format, numbers, nil)
+			if err != nil {
+				t.Log(err.Error())
+				return false
+			}
+
+			err = m.Scan(pgtype.Int4ArrayOID, format, data, &deNumbers)
+			if err != nil {
+				t.Log(err.Error())
+				return false
+			}
+
+			equal := de == ser && opt.EqualFunc(deNumbers, numbers, slices.Equal[[]int32])
+			if !equal {
+				t.Logf("ser: %s %s", ser, numbers)
+				t.Logf("de: %s %s", de, deNumbers)
+			}
+
+			return equal
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,202 @@
+package optpgx
+
+import (
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/lukasngl/opt"
+)
+
+// FromText creates an option from a [pgtype.Text], which is empty if not valid.
+//
+// Inverse of [ToText].
+func FromText(t pgtype.Text) opt.String {
+	return opt.FromOk(t.String, t.Valid)
+}
+
+// ToText returns a [pgtype.Text], which is valid if the option is present.
+//
+// Inverse of [FromText].
+func ToText(t opt.String) pgtype.Text {
+	value, present := t.Unwrap()
+
+	return pgtype.Text{String: value, Valid: present}
+}
+
+// FromBool creates an option from a [pgtype.Bool], which is empty if not valid.
+//
+// Inverse of [ToBool].
+func FromBool(b pgtype.Bool) opt.Bool {
+	return opt.FromOk(b.Bool, b.Valid)
+}
+
+// ToBool returns a [pgtype.Bool], which is valid if the option is present.
+//
+// Inverse of [FromBool].
+func ToBool(t opt.Bool) pgtype.Bool {
+	value, present := t.Unwrap()
+
+	return pgtype.Bool{Bool: value, Valid: present}
+}
+
+// FromInt2 creates an option from a [pgtype.Int2], which is empty if not valid.
+//
+// Inverse of [ToInt2].
+func FromInt2(n pgtype.Int2) opt.T[int16] {
+	return opt.FromOk(n.Int16, n.Valid)
+}
+
+// ToInt2 returns a [pgtype.Int2], which is valid if the option is present.
+//
+// Inverse of [FromInt2].
+func ToInt2(t opt.T[int16]) pgtype.Int2 {
+	value, present := t.Unwrap()
+
+	return pgtype.Int2{Int16: value, Valid: present}
+}
+
+// FromInt4 creates an option from a [pgtype.Int4], which is empty if not valid.
+//
+// Inverse of [ToInt4].
+func FromInt4(n pgtype.Int4) opt.T[int32] {
+	return opt.FromOk(n.Int32, n.Valid)
+}
+
+// ToInt4 returns a [pgtype.Int4], which is valid if the option is present.
+//
+// Inverse of [FromInt4].
+func ToInt4(t opt.T[int32]) pgtype.Int4 {
+	value, present := t.Unwrap()
+
+	return pgtype.Int4{Int32: value, Valid: present}
+}
+
+// FromInt8 creates an option from a [pgtype.Int8], which is empty if not valid.
+//
+// Inverse of [ToInt8].
+func FromInt8(n pgtype.Int8) opt.T[int64] {
+	return opt.FromOk(n.Int64, n.Valid)
+}
+
+// ToInt8 returns a [pgtype.Int8], which is valid if the option is present.
+//
+// Inverse of [FromInt8].
+func ToInt8(t opt.T[int64]) pgtype.Int8 {
+	value, present := t.Unwrap()
+
+	return pgtype.Int8{Int64: value, Valid: present}
+}
+
+// FromUint32 creates an option from a [pgtype.Uint32], which is empty if not valid.
+//
+// Inverse of [ToUint32].
+func FromUint32(n pgtype.Uint32) opt.Uint32 {
+	return opt.FromOk(n.Uint32, n.Valid)
+}
+
+// ToUint32 returns a [pgtype.Uint32], which is valid if the option is present.
+//
+// Inverse of [FromUint32].
+func ToUint32(t opt.Uint32) pgtype.Uint32 {
+	value, present := t.Unwrap()
+
+	return pgtype.Uint32{Uint32: value, Valid: present}
+}
+
+// FromFloat4 creates an option from a [pgtype.Float4], which is empty if not valid.
+//
+// Inverse of [ToFloat4].
+func FromFloat4(f pgtype.Float4) opt.Float32 {
+	return opt.FromOk(f.Float32, f.Valid)
+}
+
+// ToFloat4 returns a [pgtype.Float4], which is valid if the option is present.
+//
+// Inverse of [FromFloat4].
+func ToFloat4(t opt.Float32) pgtype.Float4 {
+	value, present := t.Unwrap()
+
+	return pgtype.Float4{Float32: value, Valid: present}
+}
+
+// FromFloat8 creates an option from a [pgtype.Float8], which is empty if not valid.
+//
+// Inverse of [ToFloat8].
+func FromFloat8(f pgtype.Float8) opt.Float64 {
+	return opt.FromOk(f.Float64, f.Valid)
+}
+
+// ToFloat8 returns a [pgtype.Float8], which is valid if the option is present.
+//
+// Inverse of [FromFloat8].
+func ToFloat8(t opt.Float64) pgtype.Float8 {
+	value, present := t.Unwrap()
+
+	return pgtype.Float8{Float64: value, Valid: present}
+}
+
+// FromTimestamptz creates an option from a [pgtype.Timestamptz],
+// which is empty if not valid or infinite, as infinity has no [time.Time] representation.
+//
+// Inverse of [ToTimestamptz].
+func FromTimestamptz(t pgtype.Timestamptz) opt.T[time.Time] {
+	return opt.FromOk(t.Time, t.Valid && t.InfinityModifier == pgtype.Finite)
+}
+
+// ToTimestamptz returns a finite [pgtype.Timestamptz], which is valid if the option is present.
+//
+// Inverse of [FromTimestamptz].
+func ToTimestamptz(t opt.T[time.Time]) pgtype.Timestamptz {
+	value, present := t.Unwrap()
+
+	return pgtype.Timestamptz{Time: value, InfinityModifier: pgtype.Finite, Valid: present}
+}
+
+// FromTimestamp creates an option from a [pgtype.Timestamp], like [FromTimestamptz].
+//
+// Inverse of [ToTimestamp].
+func FromTimestamp(t pgtype.Timestamp) opt.T[time.Time] {
+	return opt.FromOk(t.Time, t.Valid && t.InfinityModifier == pgtype.Finite)
+}
+
+// ToTimestamp returns a finite [pgtype.Timestamp], which is valid if the option is present.
+//
+// Inverse of [FromTimestamp].
+func ToTimestamp(t opt.T[time.Time]) pgtype.Timestamp {
+	value, present := t.Unwrap()
+
+	return pgtype.Timestamp{Time: value, InfinityModifier: pgtype.Finite, Valid: present}
+}
+
+// FromDate creates an option from a [pgtype.Date], like [FromTimestamptz].
+//
+// Inverse of [ToDate].
+func FromDate(d pgtype.Date) opt.T[time.Time] {
+	return opt.FromOk(d.Time, d.Valid && d.InfinityModifier == pgtype.Finite)
+}
+
+// ToDate returns a finite [pgtype.Date], which is valid if the option is present.
+//
+// Inverse of [FromDate].
+func ToDate(t opt.T[time.Time]) pgtype.Date {
+	value, present := t.Unwrap()
+
+	return pgtype.Date{Time: value, InfinityModifier: pgtype.Finite, Valid: present}
+}
+
+// FromUUID creates an option from a [pgtype.UUID], which is empty if not valid.
+//
+// Inverse of [ToUUID].
+func FromUUID(u pgtype.UUID) opt.T[[16]byte] {
+	return opt.FromOk(u.Bytes, u.Valid)
+}
+
+// ToUUID returns a [pgtype.UUID], which is valid if the option is present.
+//
+// Inverse of [FromUUID].
+func ToUUID(t opt.T[[16]byte]) pgtype.UUID {
+	value, present := t.Unwrap()
+
+	return pgtype.UUID{Bytes: value, Valid: present}
+}
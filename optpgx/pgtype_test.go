@@ -0,0 +1,66 @@
+package optpgx_test
+
+import (
+	"fmt"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optpgx"
+)
+
+// Author is a struct, as generated by sqlc for nullable columns.
+type Author struct {
+	ID   int64
+	Bio  pgtype.Text
+	Born pgtype.Date
+}
+
+func ExampleFromText() {
+	author := Author{ID: 1, Bio: pgtype.Text{String: "gopher", Valid: true}}
+
+	fmt.Println(optpgx.FromText(author.Bio), optpgx.FromDate(author.Born))
+	// Output: Some[string](gopher) None[time.Time]()
+}
+
+func ExampleToText() {
+	fmt.Printf("%+v\n", optpgx.ToText(opt.Some("gopher")))
+	fmt.Printf("%+v\n", optpgx.ToText(opt.None[string]()))
+	// Output: {String:gopher Valid:true}
+	// {String: Valid:false}
+}
+
+func ExampleFromTimestamptz() {
+	fmt.Println(optpgx.FromTimestamptz(pgtype.Timestamptz{InfinityModifier: pgtype.Infinity, Valid: true}))
+	// Output: None[time.Time]()
+}
+
+func TestConversionIdentity(t *testing.T) {
+	for name, identity := range map[string]any{
+		"text":   func(t opt.String) bool { return optpgx.FromText(optpgx.ToText(t)) == t },
+		"bool":   func(t opt.Bool) bool { return optpgx.FromBool(optpgx.ToBool(t)) == t },
+		"int2":   func(t opt.T[int16]) bool { return optpgx.FromInt2(optpgx.ToInt2(t)) == t },
+		"int4":   func(t opt.T[int32]) bool { return optpgx.FromInt4(optpgx.ToInt4(t)) == t },
+		"int8":   func(t opt.T[int64]) bool { return optpgx.FromInt8(optpgx.ToInt8(t)) == t },
+		"uint32": func(t opt.Uint32) bool { return optpgx.FromUint32(optpgx.ToUint32(t)) == t },
+		"float4": func(t opt.Float32) bool { return optpgx.FromFloat4(optpgx.ToFloat4(t)) == t },
+		"float8": func(t opt.Float64) bool { return optpgx.FromFloat8(optpgx.ToFloat8(t)) == t },
+		"uuid":   func(t opt.T[[16]byte]) bool { return optpgx.FromUUID(optpgx.ToUUID(t)) == t },
+		"timestamptz": func(seconds opt.T[int64]) bool {
+			t := opt.Map(seconds, func(s int64) time.Time { return time.Unix(s, 0) })
+			return opt.EqualFunc(optpgx.FromTimestamptz(optpgx.ToTimestamptz(t)), t, time.Time.Equal) &&
+				opt.EqualFunc(optpgx.FromTimestamp(optpgx.ToTimestamp(t)), t, time.Time.Equal) &&
+				opt.EqualFunc(optpgx.FromDate(optpgx.ToDate(t)), t, time.Time.Equal)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := quick.Check(identity, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+module github.com/lukasngl/opt/optschema
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/gorilla/schema v1.4.1
+	github.com/lukasngl/opt v0.0.0
+)
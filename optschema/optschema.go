@@ -0,0 +1,59 @@
+// Package optschema provides support for options in [github.com/gorilla/schema].
+//
+// Recent versions of gorilla/schema decode options via [opt.T.UnmarshalText] on their own,
+// the converters are needed for older versions, or when decoding into options of custom types.
+package optschema
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/gorilla/schema"
+
+	"github.com/lukasngl/opt"
+)
+
+// RegisterConverters registers converters for options of the builtin types and [time.Time],
+// covering aliases like [opt.String], but not complex numbers, see [RegisterConverter].
+func RegisterConverters(decoder *schema.Decoder) {
+	for _, register := range []func(*schema.Decoder){
+		RegisterConverter[bool],
+		RegisterConverter[string],
+		RegisterConverter[int],
+		RegisterConverter[int8],
+		RegisterConverter[int16],
+		RegisterConverter[int32],
+		RegisterConverter[int64],
+		RegisterConverter[uint],
+		RegisterConverter[uint8],
+		RegisterConverter[uint16],
+		RegisterConverter[uint32],
+		RegisterConverter[uint64],
+		RegisterConverter[float32],
+		RegisterConverter[float64],
+		RegisterConverter[time.Time],
+	} {
+		register(decoder)
+	}
+}
+
+// RegisterConverter registers a converter for options of V.
+//
+// Absent fields are left untouched, i.e. empty, while values are decoded as text,
+// see [opt.T.UnmarshalText], thus empty values are decoded as empty options as well.
+func RegisterConverter[V any](decoder *schema.Decoder) {
+	decoder.RegisterConverter(opt.T[V]{}, Convert[V])
+}
+
+// Convert converts the given value to an option of V,
+// returning an invalid value if it cannot be converted.
+func Convert[V any](value string) reflect.Value {
+	var t opt.T[V]
+
+	err := t.UnmarshalText([]byte(value))
+	if err != nil {
+		return reflect.Value{}
+	}
+
+	return reflect.ValueOf(t)
+}
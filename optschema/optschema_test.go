@@ -0,0 +1,67 @@
+package optschema_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/gorilla/schema"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optschema"
+)
+
+type Profile struct {
+	Name    string        `schema:"name"`
+	Bio     opt.String    `schema:"bio"`
+	Age     opt.T[int]    `schema:"age"`
+	Score   opt.Float64   `schema:"score"`
+	Website opt.T[string] `schema:"website"`
+}
+
+func ExampleRegisterConverters() {
+	decoder := schema.NewDecoder()
+	optschema.RegisterConverters(decoder)
+
+	var profile Profile
+
+	_ = decoder.Decode(&profile, map[string][]string{
+		"name":    {"gopher"},
+		"age":     {"13"},
+		"website": {""},
+	})
+
+	fmt.Println(profile.Name, profile.Bio, profile.Age, profile.Score, profile.Website)
+	// Output: gopher None[string]() Some[int](13) None[float64]() None[string]()
+}
+
+func TestConvertInvalid(t *testing.T) {
+	decoder := schema.NewDecoder()
+	optschema.RegisterConverters(decoder)
+
+	var profile Profile
+
+	err := decoder.Decode(&profile, map[string][]string{"age": {"thirteen"}})
+	if err == nil {
+		t.Fatalf("expected conversion error, got %v", profile)
+	}
+}
+
+func TestRegisterConverter(t *testing.T) {
+	type Level int
+
+	decoder := schema.NewDecoder()
+	optschema.RegisterConverter[Level](decoder)
+
+	var value struct {
+		Level opt.T[Level] `schema:"level"`
+	}
+
+	err := decoder.Decode(&value, map[string][]string{"level": {"3"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Contains(value.Level, 3) {
+		t.Fatalf("expected Some(3), got %s", value.Level)
+	}
+}
@@ -0,0 +1,16 @@
+module github.com/lukasngl/opt/optswaggest
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/lukasngl/opt v0.0.0
+	github.com/swaggest/jsonschema-go v0.3.78
+	github.com/swaggest/openapi-go v0.2.61
+)
+
+require (
+	github.com/swaggest/refl v1.4.0 // indirect
+	gopkg.in/yaml.v2 v2.4.0 // indirect
+)
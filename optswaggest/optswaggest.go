@@ -0,0 +1,55 @@
+// Package optswaggest provides JSON Schema support for options
+// using [github.com/swaggest/jsonschema-go],
+// and thus [github.com/swaggest/openapi-go].
+//
+// Without it, options are described as strings, as they implement
+// [encoding.TextUnmarshaler], instead of the schema of their value.
+package optswaggest
+
+import (
+	"reflect"
+
+	"github.com/swaggest/jsonschema-go"
+
+	"github.com/lukasngl/opt"
+)
+
+// Register adds [InterceptSchema] to the default options of the given reflector.
+//
+// For [github.com/swaggest/openapi-go] use the reflector
+// returned by JSONSchemaReflector.
+func Register(r *jsonschema.Reflector) {
+	r.DefaultOptions = append(r.DefaultOptions, InterceptSchema(r))
+}
+
+// InterceptSchema returns an option, describing options as the schema
+// of their value or null.
+//
+// The schema of the value is reflected inline using the given reflector.
+func InterceptSchema(r *jsonschema.Reflector) func(*jsonschema.ReflectContext) {
+	return jsonschema.InterceptSchema(func(params jsonschema.InterceptSchemaParams) (bool, error) {
+		if params.Processed || !params.Value.IsValid() {
+			return false, nil
+		}
+
+		elem, ok := opt.ValueTypeOf(params.Value.Type())
+		if !ok {
+			return false, nil
+		}
+
+		schema, err := r.Reflect(reflect.Zero(elem).Interface(), jsonschema.InlineRefs)
+		if err != nil {
+			return true, err
+		}
+
+		// values without type, e.g. interfaces, already accept null.
+		if schema.Type != nil {
+			schema.AddType(jsonschema.Null)
+		}
+
+		schema.ReflectType = params.Schema.ReflectType
+		*params.Schema = schema
+
+		return true, nil
+	})
+}
@@ -0,0 +1,99 @@
+package optswaggest_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/swaggest/jsonschema-go"
+	"github.com/swaggest/openapi-go/openapi3"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/optswaggest"
+)
+
+type Address struct {
+	City   string        `json:"city"`
+	Street opt.T[string] `json:"street"`
+}
+
+type User struct {
+	Name    string         `json:"name"`
+	Age     opt.T[int64]   `json:"age,omitzero"`
+	Address opt.T[Address] `json:"address"`
+}
+
+func ExampleRegister() {
+	//nolint:exhaustruct
+	reflector := &jsonschema.Reflector{}
+	optswaggest.Register(reflector)
+
+	schema, _ := reflector.Reflect(User{}, jsonschema.InlineRefs)
+
+	for _, name := range []string{"name", "age", "address"} {
+		data, _ := json.Marshal(schema.Properties[name])
+		fmt.Println(string(data))
+	}
+	// Output:
+	// {"type":"string"}
+	// {"type":["integer","null"]}
+	// {"properties":{"city":{"type":"string"},"street":{"type":["string","null"]}},"type":["object","null"]}
+}
+
+func TestRegisterInterface(t *testing.T) {
+	t.Parallel()
+
+	type Event struct {
+		Payload opt.T[any] `json:"payload"`
+	}
+
+	//nolint:exhaustruct
+	reflector := &jsonschema.Reflector{}
+	optswaggest.Register(reflector)
+
+	schema, err := reflector.Reflect(Event{}, jsonschema.InlineRefs)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := json.Marshal(schema.Properties["payload"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "{}" {
+		t.Errorf("expected payload to accept anything, got %s", data)
+	}
+}
+
+func TestRegisterOpenAPI(t *testing.T) {
+	t.Parallel()
+
+	type Query struct {
+		Limit opt.T[int] `json:"limit"`
+	}
+
+	reflector := openapi3.NewReflector()
+	optswaggest.Register(reflector.JSONSchemaReflector())
+
+	operation, err := reflector.NewOperationContext(http.MethodPost, "/search")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	operation.AddReqStructure(Query{})
+
+	err = reflector.AddOperation(operation)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	schema := reflector.Spec.Components.Schemas.MapOfSchemaOrRefValues["OptswaggestTestQuery"].Schema
+	limit := schema.Properties["limit"].Schema
+
+	if limit.Type == nil || *limit.Type != openapi3.SchemaTypeInteger || limit.Nullable == nil || !*limit.Nullable {
+		data, _ := json.Marshal(limit)
+		t.Errorf("expected nullable integer, got %s", data)
+	}
+}
@@ -0,0 +1,92 @@
+package opt
+
+// Pair holds two values of possibly different types.
+//
+// It is encoded to JSON as an object with the keys "first" and "second".
+type Pair[A, B any] struct {
+	First  A `json:"first"`
+	Second B `json:"second"`
+}
+
+// Triple holds three values of possibly different types.
+//
+// It is encoded to JSON as an object with the keys "first", "second" and "third".
+type Triple[A, B, C any] struct {
+	First  A `json:"first"`
+	Second B `json:"second"`
+	Third  C `json:"third"`
+}
+
+// MapFirst applies fn to the first value of the pair.
+func MapFirst[A, B, C any](p Pair[A, B], fn func(A) C) Pair[C, B] {
+	return Pair[C, B]{First: fn(p.First), Second: p.Second}
+}
+
+// MapSecond applies fn to the second value of the pair.
+func MapSecond[A, B, C any](p Pair[A, B], fn func(B) C) Pair[A, C] {
+	return Pair[A, C]{First: p.First, Second: fn(p.Second)}
+}
+
+// Zip combines two options into an option of a [Pair],
+// which is only present if both options are present.
+//
+// Inverse of [Unzip].
+func Zip[A, B any](a T[A], b T[B]) T[Pair[A, B]] {
+	first, present := a.Unwrap()
+	if !present {
+		return None[Pair[A, B]]()
+	}
+
+	second, present := b.Unwrap()
+	if !present {
+		return None[Pair[A, B]]()
+	}
+
+	return Some(Pair[A, B]{First: first, Second: second})
+}
+
+// Unzip splits an option of a [Pair] into two options,
+// which are both present if the given option is present,
+// and both empty otherwise.
+//
+// Inverse of [Zip].
+func Unzip[A, B any](t T[Pair[A, B]]) (T[A], T[B]) {
+	pair, present := t.Unwrap()
+	if !present {
+		return None[A](), None[B]()
+	}
+
+	return Some(pair.First), Some(pair.Second)
+}
+
+// Zip3 combines three options into an option of a [Triple],
+// which is only present if all options are present.
+//
+// Inverse of [Unzip3].
+func Zip3[A, B, C any](a T[A], b T[B], c T[C]) T[Triple[A, B, C]] {
+	pair, present := Zip(a, b).Unwrap()
+	if !present {
+		return None[Triple[A, B, C]]()
+	}
+
+	third, present := c.Unwrap()
+	if !present {
+		return None[Triple[A, B, C]]()
+	}
+
+	return Some(Triple[A, B, C]{First: pair.First, Second: pair.Second, Third: third})
+}
+
+// Unzip3 splits an option of a [Triple] into three options,
+// which are all present if the given option is present,
+// and all empty otherwise.
+//
+// Inverse of [Zip3].
+func Unzip3[A, B, C any](t T[Triple[A, B, C]]) (T[A], T[B], T[C]) {
+	triple, present := t.Unwrap()
+	if !present {
+		return None[A](), None[B](), None[C]()
+	}
+
+	return Some(triple.First), Some(triple.Second), Some(triple.Third)
+}
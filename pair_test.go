@@ -0,0 +1,72 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleZip() {
+	host := opt.Some("localhost")
+	port := opt.Some(8080)
+
+	fmt.Println(opt.Zip(host, port))
+	fmt.Println(opt.Zip(host, opt.None[int]()))
+	// Output: Some[opt.Pair[string,int]]({localhost 8080})
+	// None[opt.Pair[string,int]]()
+}
+
+func ExampleZip3() {
+	fmt.Println(opt.Zip3(opt.Some("a"), opt.Some(1), opt.Some(true)))
+	// Output: Some[opt.Triple[string,int,bool]]({a 1 true})
+}
+
+func ExampleMapFirst() {
+	pair := opt.Pair[int, string]{First: 42, Second: "answer"}
+
+	fmt.Println(opt.MapFirst(pair, strconv.Itoa))
+	fmt.Println(opt.MapSecond(pair, strconv.Quote))
+	// Output: {42 answer}
+	// {42 "answer"}
+}
+
+func ExamplePair() {
+	data, _ := json.Marshal(opt.Zip(opt.Some("localhost"), opt.Some(8080)))
+
+	fmt.Println(string(data))
+	// Output: {"first":"localhost","second":8080}
+}
+
+func TestZipUnzipIdentity(t *testing.T) {
+	err := quick.Check(func(a opt.T[string], b opt.T[int]) bool {
+		first, second := opt.Unzip(opt.Zip(a, b))
+
+		if a.IsPresent() && b.IsPresent() {
+			return first == a && second == b
+		}
+
+		return first.IsEmpty() && second.IsEmpty()
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestZip3Unzip3Identity(t *testing.T) {
+	err := quick.Check(func(a opt.T[string], b opt.T[int], c opt.T[bool]) bool {
+		first, second, third := opt.Unzip3(opt.Zip3(a, b, c))
+
+		if a.IsPresent() && b.IsPresent() && c.IsPresent() {
+			return first == a && second == b && third == c
+		}
+
+		return first.IsEmpty() && second.IsEmpty() && third.IsEmpty()
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
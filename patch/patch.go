@@ -0,0 +1,145 @@
+// Package patch provides a tri-state field type for partial updates,
+// e.g. HTTP PATCH requests, which distinguishes between an undefined field,
+// an explicit null, and a value.
+package patch
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+
+	"github.com/lukasngl/opt"
+)
+
+// Field represents a field of a partial update,
+// that is either undefined, explicitly null, or holds a value.
+//
+// The zero value is undefined.
+type Field[V any] struct {
+	value   opt.T[V]
+	defined bool
+}
+
+// Undefined creates a new undefined field, i.e. a field that should be left alone.
+func Undefined[V any]() Field[V] {
+	//nolint:exhaustruct
+	return Field[V]{}
+}
+
+// Null creates a new explicitly null field, i.e. a field that should be cleared.
+func Null[V any]() Field[V] {
+	return Field[V]{
+		value:   opt.None[V](),
+		defined: true,
+	}
+}
+
+// Value creates a new field, that holds the given value.
+func Value[V any](value V) Field[V] {
+	return Field[V]{
+		value:   opt.Some(value),
+		defined: true,
+	}
+}
+
+// FromOpt creates a new defined field from an option,
+// which is null if the option is empty.
+//
+// Inverse of [Field.Opt] for defined fields.
+func FromOpt[V any](t opt.T[V]) Field[V] {
+	return Field[V]{
+		value:   t,
+		defined: true,
+	}
+}
+
+// Opt returns an option containing the value if present,
+// and an empty option if the field is null or undefined.
+func (f Field[V]) Opt() opt.T[V] {
+	return f.value
+}
+
+// IsZero returns whether the field is undefined.
+// From go1.24 this can be used with omitzero struct tag.
+func (f Field[V]) IsZero() bool {
+	return !f.defined
+}
+
+// IsUndefined returns whether the field is undefined.
+func (f Field[V]) IsUndefined() bool {
+	return !f.defined
+}
+
+// IsDefined returns whether the field is either null or holds a value.
+func (f Field[V]) IsDefined() bool {
+	return f.defined
+}
+
+// IsNull returns whether the field is explicitly null.
+func (f Field[V]) IsNull() bool {
+	return f.defined && f.value.IsEmpty()
+}
+
+// IsPresent returns whether the field holds a value.
+func (f Field[V]) IsPresent() bool {
+	return f.value.IsPresent()
+}
+
+// Unwrap returns the value and whether it is present.
+func (f Field[V]) Unwrap() (V, bool) {
+	return f.value.Unwrap()
+}
+
+// String implements [fmt.Stringer].
+func (f Field[V]) String() string {
+	value, present := f.value.Unwrap()
+
+	switch {
+	case !f.defined:
+		return fmt.Sprintf("Undefined[%T]()", value)
+	case !present:
+		return fmt.Sprintf("Null[%T]()", value)
+	default:
+		return fmt.Sprintf("Value[%T](%v)", value, value)
+	}
+}
+
+// JSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &Field[any]{}
+	_ json.Marshaler   = Field[any]{}
+)
+
+// MarshalJSON implements [json.Marshaler].
+//
+// Undefined fields are encoded as null,
+// use the omitzero struct tag to omit them instead.
+func (f Field[V]) MarshalJSON() ([]byte, error) {
+	return f.value.MarshalJSON()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+//
+// As UnmarshalJSON is only called for keys that are present,
+// fields that are absent from the input remain undefined.
+func (f *Field[V]) UnmarshalJSON(data []byte) error {
+	err := f.value.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	f.defined = true
+
+	return nil
+}
+
+// Database Value.
+var _ driver.Valuer = Field[any]{}
+
+// Value implements [driver.Valuer].
+//
+// Both undefined and null fields are mapped to NULL,
+// callers are expected to skip undefined fields when building updates.
+func (f Field[V]) Value() (driver.Value, error) {
+	return f.value.Value()
+}
@@ -0,0 +1,60 @@
+package patch_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/patch"
+)
+
+func ExampleField_UnmarshalJSON() {
+	var update struct {
+		Name     patch.Field[string] `json:"name"`
+		Nickname patch.Field[string] `json:"nickname"`
+		Email    patch.Field[string] `json:"email"`
+	}
+
+	_ = json.Unmarshal([]byte(`{"name":"gopher","nickname":null}`), &update)
+
+	fmt.Println(update.Name)
+	fmt.Println(update.Nickname)
+	fmt.Println(update.Email)
+	// Output: Value[string](gopher)
+	// Null[string]()
+	// Undefined[string]()
+}
+
+func ExampleFromOpt() {
+	fmt.Println(patch.FromOpt(opt.Some(42)))
+	fmt.Println(patch.FromOpt(opt.None[int]()))
+	// Output: Value[int](42)
+	// Null[int]()
+}
+
+func TestValue(t *testing.T) {
+	for _, field := range []patch.Field[string]{patch.Undefined[string](), patch.Null[string]()} {
+		value, err := field.Value()
+		if err != nil || value != nil {
+			t.Errorf("%s: expected NULL, got %v, %v", field, value, err)
+		}
+	}
+
+	value, err := patch.Value("gopher").Value()
+	if err != nil || value != "gopher" {
+		t.Errorf("expected gopher, got %v, %v", value, err)
+	}
+}
+
+func TestFromOptIdentity(t *testing.T) {
+	err := quick.Check(func(input opt.T[string]) bool {
+		field := patch.FromOpt(input)
+
+		return field.IsDefined() && opt.Equal(input, field.Opt())
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,95 @@
+package opt
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// ProtoJSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &ProtoJSON[any]{}
+	_ json.Marshaler   = ProtoJSON[any]{}
+)
+
+// ProtoJSON is an option, that is encoded following the proto3 JSON mapping,
+// as used by protojson for optional fields.
+//
+// That is 64-bit integers are encoded as strings and non-finite floats
+// as "NaN", "Infinity" and "-Infinity", while both forms are accepted when decoding.
+// Use the omitzero tag to omit empty options, while still emitting present zero values.
+type ProtoJSON[V any] struct {
+	T[V]
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (p ProtoJSON[V]) MarshalJSON() ([]byte, error) {
+	value, present := p.Unwrap()
+	if !present {
+		return []byte("null"), nil
+	}
+
+	if _, ok := any(&value).(json.Marshaler); ok {
+		return json.Marshal(&value)
+	}
+
+	rv := reflect.ValueOf(&value).Elem()
+
+	switch rv.Kind() {
+	case reflect.Int64:
+		return strconv.AppendQuote(nil, strconv.FormatInt(rv.Int(), 10)), nil
+	case reflect.Uint64:
+		return strconv.AppendQuote(nil, strconv.FormatUint(rv.Uint(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		switch f := rv.Float(); {
+		case math.IsNaN(f):
+			return []byte(`"NaN"`), nil
+		case math.IsInf(f, 1):
+			return []byte(`"Infinity"`), nil
+		case math.IsInf(f, -1):
+			return []byte(`"-Infinity"`), nil
+		}
+	}
+
+	return json.Marshal(&value)
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (p *ProtoJSON[V]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		p.T = None[V]()
+
+		return nil
+	}
+
+	var value V
+
+	if _, ok := any(&value).(json.Unmarshaler); !ok && len(data) > 0 && data[0] == '"' {
+		switch reflect.ValueOf(&value).Elem().Kind() {
+		case reflect.Int64, reflect.Uint64, reflect.Float32, reflect.Float64:
+			text, err := strconv.Unquote(string(data))
+			if err != nil {
+				return err
+			}
+
+			err = unmarshalText([]byte(text), &value)
+			if err != nil {
+				return err
+			}
+
+			p.T = Some(value)
+
+			return nil
+		}
+	}
+
+	err := json.Unmarshal(data, &value)
+	if err != nil {
+		return err
+	}
+
+	p.T = Some(value)
+
+	return nil
+}
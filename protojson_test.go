@@ -0,0 +1,89 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleProtoJSON_MarshalJSON() {
+	type Account struct {
+		ID      opt.ProtoJSON[int64]   `json:"id"`
+		Balance opt.ProtoJSON[float64] `json:"balance"`
+		Name    opt.ProtoJSON[string]  `json:"name"`
+	}
+
+	data, _ := json.Marshal(Account{
+		ID:      opt.ProtoJSON[int64]{T: opt.Some[int64](0)},
+		Balance: opt.ProtoJSON[float64]{T: opt.Some(math.Inf(1))},
+		Name:    opt.ProtoJSON[string]{T: opt.Some("")},
+	})
+
+	fmt.Println(string(data))
+	// Output: {"id":"0","balance":"Infinity","name":""}
+}
+
+func ExampleProtoJSON_UnmarshalJSON() {
+	type Account struct {
+		ID      opt.ProtoJSON[int64]   `json:"id"`
+		Balance opt.ProtoJSON[float64] `json:"balance"`
+		Name    opt.ProtoJSON[string]  `json:"name"`
+	}
+
+	var account Account
+
+	_ = json.Unmarshal([]byte(`{"id":42,"balance":"NaN","name":null}`), &account)
+
+	fmt.Println(account.ID, account.Balance, account.Name)
+	// Output: Some[int64](42) Some[float64](NaN) None[string]()
+}
+
+func TestProtoJSONIdentity(t *testing.T) {
+	type Thing struct {
+		Int64  opt.ProtoJSON[int64]   `json:"int64"`
+		Uint64 opt.ProtoJSON[uint64]  `json:"uint64"`
+		Int32  opt.ProtoJSON[int32]   `json:"int32"`
+		Bytes  opt.ProtoJSON[[]byte]  `json:"bytes"`
+		Float  opt.ProtoJSON[float64] `json:"float"`
+	}
+
+	err := quick.Check(func(i opt.T[int64], u opt.T[uint64], i32 opt.T[int32], b opt.T[[]byte], f opt.T[float64]) bool {
+		var de Thing
+
+		ser := Thing{
+			Int64:  opt.ProtoJSON[int64]{T: i},
+			Uint64: opt.ProtoJSON[uint64]{T: u},
+			Int32:  opt.ProtoJSON[int32]{T: i32},
+			Bytes:  opt.ProtoJSON[[]byte]{T: b},
+			Float:  opt.ProtoJSON[float64]{T: f},
+		}
+
+		data, err := json.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = json.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		equal := fmt.Sprint(de) == fmt.Sprint(ser)
+		if !equal {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+			t.Log(string(data))
+		}
+
+		return equal
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,134 @@
+package opt
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Raw is an option, that distinguishes between an absent value, an explicit null,
+// and a value, remembering the JSON it was unmarshaled from.
+//
+// Marshaling reproduces the input, e.g. when proxying third-party APIs,
+// even if V does not round-trip, like numbers exceeding float64 or unknown fields.
+// Note that encoding/json still compacts the output of marshalers, and escapes HTML by default.
+//
+// The zero value is absent, use the omitzero struct tag to omit it.
+type Raw[V any] struct {
+	value   T[V]
+	defined bool
+	raw     json.RawMessage
+}
+
+// RawAbsent creates a new absent raw option.
+func RawAbsent[V any]() Raw[V] {
+	//nolint:exhaustruct
+	return Raw[V]{}
+}
+
+// RawNull creates a new raw option, that is explicitly null.
+func RawNull[V any]() Raw[V] {
+	return RawFrom(None[V]())
+}
+
+// RawSome creates a new raw option containing the given value.
+func RawSome[V any](value V) Raw[V] {
+	return RawFrom(Some(value))
+}
+
+// RawFrom creates a new defined raw option from an option,
+// which is null if the option is empty.
+//
+// Inverse of [Raw.Opt] for defined raw options.
+func RawFrom[V any](t T[V]) Raw[V] {
+	//nolint:exhaustruct
+	return Raw[V]{
+		value:   t,
+		defined: true,
+	}
+}
+
+// Opt returns the option, which is empty if absent or null.
+func (r Raw[V]) Opt() T[V] {
+	return r.value
+}
+
+// Unwrap returns the value and whether it is present.
+func (r Raw[V]) Unwrap() (V, bool) {
+	return r.value.Unwrap()
+}
+
+// IsZero returns whether the value is absent.
+// From go1.24 this can be used with omitzero struct tag.
+func (r Raw[V]) IsZero() bool {
+	return !r.defined
+}
+
+// IsDefined returns whether the value is either null or present.
+func (r Raw[V]) IsDefined() bool {
+	return r.defined
+}
+
+// IsNull returns whether the value is explicitly null.
+func (r Raw[V]) IsNull() bool {
+	return r.defined && !r.value.IsPresent()
+}
+
+// IsPresent returns whether the value is present.
+func (r Raw[V]) IsPresent() bool {
+	return r.value.IsPresent()
+}
+
+// RawJSON returns the JSON the option was unmarshaled from,
+// or nil if it was not unmarshaled or is absent.
+func (r Raw[V]) RawJSON() json.RawMessage {
+	return r.raw
+}
+
+// String implements [fmt.Stringer].
+func (r Raw[V]) String() string {
+	value, present := r.value.Unwrap()
+
+	switch {
+	case !r.defined:
+		return fmt.Sprintf("Absent[%T]()", value)
+	case !present:
+		return fmt.Sprintf("Null[%T]()", value)
+	default:
+		return fmt.Sprintf("Some[%T](%s)", value, coerceString(value))
+	}
+}
+
+// JSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &Raw[any]{}
+	_ json.Marshaler   = Raw[any]{}
+)
+
+// MarshalJSON implements [json.Marshaler].
+//
+// The JSON the option was unmarshaled from is returned as is,
+// otherwise the option is marshaled, encoding absent values as null.
+func (r Raw[V]) MarshalJSON() ([]byte, error) {
+	if r.raw != nil {
+		return r.raw, nil
+	}
+
+	return r.value.MarshalJSON()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+//
+// As UnmarshalJSON is only called for keys that are present,
+// values that are absent from the input remain absent.
+func (r *Raw[V]) UnmarshalJSON(data []byte) error {
+	var value T[V]
+
+	err := value.UnmarshalJSON(data)
+	if err != nil {
+		return err
+	}
+
+	r.value, r.defined, r.raw = value, true, append(json.RawMessage(nil), data...)
+
+	return nil
+}
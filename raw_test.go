@@ -0,0 +1,110 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+type Upstream struct {
+	Nickname opt.Raw[string]  `json:"nickname"`
+	Balance  opt.Raw[float64] `json:"balance"`
+	Settings opt.Raw[struct {
+		Theme string `json:"theme"`
+	}] `json:"settings"`
+}
+
+func ExampleRaw() {
+	input := `{"nickname":null,"balance":12345678901234567890,"settings":{"theme":"dark","beta":true}}`
+
+	var upstream Upstream
+
+	_ = json.Unmarshal([]byte(input), &upstream)
+
+	fmt.Println(upstream.Nickname, upstream.Balance.IsPresent(), upstream.Settings.Opt().OrZero().Theme)
+
+	output, _ := json.Marshal(upstream)
+
+	fmt.Println(string(output) == input)
+	// Output: Null[string]() true dark
+	// true
+}
+
+func ExampleRaw_IsZero() {
+	var upstream Upstream
+
+	_ = json.Unmarshal([]byte(`{"balance":1.50}`), &upstream)
+
+	fmt.Println(upstream.Nickname.IsZero(), upstream.Nickname.IsNull(), upstream.Balance)
+	// Output: true false Some[float64](1.5)
+}
+
+func TestRawConstructed(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		raw  opt.Raw[int]
+		json string
+		str  string
+	}{
+		{raw: opt.RawAbsent[int](), json: "null", str: "Absent[int]()"},
+		{raw: opt.RawNull[int](), json: "null", str: "Null[int]()"},
+		{raw: opt.RawSome(1), json: "1", str: "Some[int](1)"},
+		{raw: opt.RawFrom(opt.None[int]()), json: "null", str: "Null[int]()"},
+	}
+
+	for _, c := range cases {
+		data, err := json.Marshal(c.raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if string(data) != c.json {
+			t.Errorf("expected %s to marshal to %s, got %s", c.str, c.json, data)
+		}
+
+		if c.raw.String() != c.str {
+			t.Errorf("expected %s, got %s", c.str, c.raw)
+		}
+
+		if c.raw.RawJSON() != nil {
+			t.Errorf("expected no raw json for %s, got %s", c.str, c.raw.RawJSON())
+		}
+	}
+}
+
+func TestRawUnmarshalError(t *testing.T) {
+	t.Parallel()
+
+	raw := opt.RawSome(1)
+
+	err := json.Unmarshal([]byte(`"one"`), &raw)
+	if err == nil {
+		t.Fatal("expected error unmarshaling a string into an int")
+	}
+
+	if raw.Opt() != opt.Some(1) || raw.RawJSON() != nil {
+		t.Errorf("expected raw option to be untouched, got %v", raw)
+	}
+}
+
+func TestRawUnmarshalCopiesInput(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`"gopher"`)
+
+	var raw opt.Raw[string]
+
+	err := raw.UnmarshalJSON(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	copy(data, `"mutant"`)
+
+	if string(raw.RawJSON()) != `"gopher"` {
+		t.Errorf("expected raw json to be copied, got %s", raw.RawJSON())
+	}
+}
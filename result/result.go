@@ -0,0 +1,151 @@
+// Package result provides a type representing either a value or an error,
+// complementing [opt.T] for computations that may fail.
+package result
+
+import (
+	"fmt"
+
+	"github.com/lukasngl/opt"
+)
+
+// T represents a result, i.e. either a value or an error.
+type T[V any] struct {
+	v   V
+	err error
+}
+
+// Ok creates a new successful result, that contains the given value.
+func Ok[V any](value V) T[V] {
+	return T[V]{
+		v:   value,
+		err: nil,
+	}
+}
+
+// Err creates a new failed result, that contains the given error.
+//
+// Panics if err is nil.
+func Err[V any](err error) T[V] {
+	if err == nil {
+		panic("called result.Err with a nil error")
+	}
+
+	//nolint:exhaustruct
+	return T[V]{err: err}
+}
+
+// From creates a new result from a value and an error,
+// as commonly returned by functions.
+//
+// If the error is non-nil a failed result is returned,
+// otherwise a successful result containing the value.
+//
+// Inverse of [T.Unwrap].
+func From[V any](value V, err error) T[V] {
+	if err != nil {
+		return Err[V](err)
+	}
+
+	return Ok(value)
+}
+
+// FromOpt creates a new result from an option,
+// using err if the option is empty, see [opt.T.OkOr].
+//
+// Inverse of [T.ToOpt] for successful results.
+func FromOpt[V any](t opt.T[V], err error) T[V] {
+	return From(t.OkOr(err))
+}
+
+// IsOk returns whether the result is successful.
+func (r T[V]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns whether the result is failed.
+func (r T[V]) IsErr() bool {
+	return r.err != nil
+}
+
+// Err returns the error of a failed result, and nil otherwise.
+func (r T[V]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the wrapped value and error.
+func (r T[V]) Unwrap() (V, error) {
+	if r.err != nil {
+		var zero V
+
+		return zero, r.err
+	}
+
+	return r.v, nil
+}
+
+// Must returns the wrapped value and panics with the error if the result is failed.
+func (r T[V]) Must() V {
+	value, err := r.Unwrap()
+	if err != nil {
+		panic(err)
+	}
+
+	return value
+}
+
+// OrElse returns the wrapped value if successful and the given default value otherwise.
+func (r T[V]) OrElse(defaultValue V) V {
+	value, err := r.Unwrap()
+	if err != nil {
+		return defaultValue
+	}
+
+	return value
+}
+
+// ToOpt returns an option containing the wrapped value if successful,
+// and an empty option otherwise, discarding the error.
+func (r T[V]) ToOpt() opt.T[V] {
+	return opt.FromErr(r.Unwrap())
+}
+
+// String implements [fmt.Stringer].
+func (r T[V]) String() string {
+	value, err := r.Unwrap()
+	if err != nil {
+		return fmt.Sprintf("Err[%T](%s)", value, err)
+	}
+
+	return fmt.Sprintf("Ok[%T](%v)", value, value)
+}
+
+// Map applies fn to the wrapped value if successful,
+// and propagates the error otherwise.
+func Map[V, W any](r T[V], fn func(V) W) T[W] {
+	value, err := r.Unwrap()
+	if err != nil {
+		return Err[W](err)
+	}
+
+	return Ok(fn(value))
+}
+
+// FlatMap applies fn to the wrapped value if successful,
+// and propagates the error otherwise.
+//
+// In contrast to [Map], fn itself returns a result,
+// which allows chaining computations that may fail.
+func FlatMap[V, W any](r T[V], fn func(V) T[W]) T[W] {
+	value, err := r.Unwrap()
+	if err != nil {
+		return Err[W](err)
+	}
+
+	return fn(value)
+}
+
+// AndThen is the method form of [FlatMap],
+// restricted to functions that do not change the type.
+func (r T[V]) AndThen(fn func(V) T[V]) T[V] {
+	return FlatMap(r, fn)
+}
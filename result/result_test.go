@@ -0,0 +1,71 @@
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/result"
+)
+
+func ExampleFrom() {
+	fmt.Println(result.From(strconv.Atoi("42")))
+	fmt.Println(result.From(strconv.Atoi("nope")))
+	// Output: Ok[int](42)
+	// Err[int](strconv.Atoi: parsing "nope": invalid syntax)
+}
+
+func ExampleFromOpt() {
+	errMissing := errors.New("missing port")
+
+	fmt.Println(result.FromOpt(opt.Some(8080), errMissing))
+	fmt.Println(result.FromOpt(opt.None[int](), errMissing))
+	// Output: Ok[int](8080)
+	// Err[int](missing port)
+}
+
+func ExampleMap() {
+	parsed := result.From(strconv.Atoi("21"))
+
+	fmt.Println(result.Map(parsed, func(v int) int { return v * 2 }))
+	// Output: Ok[int](42)
+}
+
+func ExampleT_AndThen() {
+	errOdd := errors.New("odd")
+	half := func(v int) result.T[int] {
+		if v%2 != 0 {
+			return result.Err[int](errOdd)
+		}
+
+		return result.Ok(v / 2)
+	}
+
+	fmt.Println(result.Ok(8).AndThen(half).AndThen(half))
+	fmt.Println(result.Ok(6).AndThen(half).AndThen(half))
+	// Output: Ok[int](2)
+	// Err[int](odd)
+}
+
+func ExampleT_ToOpt() {
+	fmt.Println(result.Ok("value").ToOpt())
+	fmt.Println(result.Err[string](errors.New("boom")).ToOpt())
+	// Output: Some[string](value)
+	// None[string]()
+}
+
+func TestFromOptIdentity(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	err := quick.Check(func(input opt.T[string]) bool {
+		output := result.FromOpt(input, errMissing).ToOpt()
+
+		return opt.Equal(input, output)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,152 @@
+package opt
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// Secret is an option, whose wrapped value is redacted when formatted,
+// logged or marshalled, e.g. for optional credentials.
+//
+// Use [Secret.Reveal] to access the wrapped value,
+// e.g. to marshal it deliberately.
+type Secret[V any] struct {
+	t T[V]
+}
+
+// Conceal creates a new secret from an option.
+//
+// Inverse of [Secret.Reveal].
+func Conceal[V any](t T[V]) Secret[V] {
+	return Secret[V]{t: t}
+}
+
+// Reveal returns the option containing the unredacted value.
+//
+// Inverse of [Conceal].
+func (s Secret[V]) Reveal() T[V] {
+	return s.t
+}
+
+// IsZero returns whether the option is empty.
+// From go1.24 this can be used with omitzero struct tag.
+func (s Secret[V]) IsZero() bool {
+	return !s.t.present
+}
+
+// IsPresent returns whether the option is present.
+func (s Secret[V]) IsPresent() bool {
+	return s.t.present
+}
+
+// String implements [fmt.Stringer].
+func (s Secret[V]) String() string {
+	value, present := s.t.Unwrap()
+	if !present {
+		return fmt.Sprintf("None[%T]()", value)
+	}
+
+	return fmt.Sprintf("Some[%T](REDACTED)", value)
+}
+
+// Formatting and logging.
+var (
+	_ fmt.Formatter  = Secret[any]{}
+	_ slog.LogValuer = Secret[any]{}
+)
+
+// Format implements [fmt.Formatter],
+// such that every verb, including %#v, prints the redacted [Secret.String].
+func (s Secret[V]) Format(f fmt.State, _ rune) {
+	_, _ = fmt.Fprint(f, s.String())
+}
+
+// LogValue implements [slog.LogValuer].
+func (s Secret[V]) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// JSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &Secret[any]{}
+	_ json.Marshaler   = Secret[any]{}
+)
+
+// MarshalJSON implements [json.Marshaler].
+//
+// Present values are encoded as the string "REDACTED",
+// marshal [Secret.Reveal] or use [RevealedSecret] to encode the wrapped value instead.
+func (s Secret[V]) MarshalJSON() ([]byte, error) {
+	if !s.t.present {
+		return []byte("null"), nil
+	}
+
+	return []byte(`"REDACTED"`), nil
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (s *Secret[V]) UnmarshalJSON(data []byte) error {
+	return s.t.UnmarshalJSON(data)
+}
+
+// RevealedSecret is a [Secret], whose wrapped value is encoded when marshalled to JSON,
+// e.g. for credentials persisted in configuration files,
+// while still redacted when formatted or logged.
+//
+// Convert between both types to switch the JSON encoding,
+// e.g. RevealedSecret[V](s) before marshalling.
+type RevealedSecret[V any] Secret[V]
+
+// Reveal returns the option containing the unredacted value.
+func (s RevealedSecret[V]) Reveal() T[V] {
+	return s.t
+}
+
+// IsZero returns whether the option is empty.
+// From go1.24 this can be used with omitzero struct tag.
+func (s RevealedSecret[V]) IsZero() bool {
+	return !s.t.present
+}
+
+// IsPresent returns whether the option is present.
+func (s RevealedSecret[V]) IsPresent() bool {
+	return s.t.present
+}
+
+// String implements [fmt.Stringer], like [Secret.String].
+func (s RevealedSecret[V]) String() string {
+	return Secret[V](s).String()
+}
+
+// Formatting and logging.
+var (
+	_ fmt.Formatter  = RevealedSecret[any]{}
+	_ slog.LogValuer = RevealedSecret[any]{}
+)
+
+// Format implements [fmt.Formatter], like [Secret.Format].
+func (s RevealedSecret[V]) Format(f fmt.State, _ rune) {
+	_, _ = fmt.Fprint(f, s.String())
+}
+
+// LogValue implements [slog.LogValuer], like [Secret.LogValue].
+func (s RevealedSecret[V]) LogValue() slog.Value {
+	return slog.StringValue(s.String())
+}
+
+// JSON Marshalling und Unmarshalling.
+var (
+	_ json.Unmarshaler = &RevealedSecret[any]{}
+	_ json.Marshaler   = RevealedSecret[any]{}
+)
+
+// MarshalJSON implements [json.Marshaler], encoding the option like [T.MarshalJSON].
+func (s RevealedSecret[V]) MarshalJSON() ([]byte, error) {
+	return s.t.MarshalJSON()
+}
+
+// UnmarshalJSON implements [json.Unmarshaler].
+func (s *RevealedSecret[V]) UnmarshalJSON(data []byte) error {
+	return s.t.UnmarshalJSON(data)
+}
@@ -0,0 +1,104 @@
+package opt_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleSecret() {
+	password := opt.Conceal(opt.Some("hunter2"))
+
+	fmt.Println(password)
+	fmt.Printf("%#v %q\n", password, password)
+	fmt.Println(password.Reveal())
+	// Output: Some[string](REDACTED)
+	// Some[string](REDACTED) Some[string](REDACTED)
+	// Some[string](hunter2)
+}
+
+func ExampleSecret_MarshalJSON() {
+	var credentials struct {
+		User     string             `json:"user"`
+		Password opt.Secret[string] `json:"password"`
+	}
+
+	_ = json.Unmarshal([]byte(`{"user":"gopher","password":"hunter2"}`), &credentials)
+
+	data, _ := json.Marshal(credentials)
+	fmt.Println(string(data))
+
+	revealed, _ := json.Marshal(credentials.Password.Reveal())
+	fmt.Println(string(revealed))
+	// Output: {"user":"gopher","password":"REDACTED"}
+	// "hunter2"
+}
+
+func ExampleRevealedSecret() {
+	var config struct {
+		Token opt.RevealedSecret[string] `json:"token"`
+	}
+
+	_ = json.Unmarshal([]byte(`{"token":"hunter2"}`), &config)
+
+	data, _ := json.Marshal(config)
+	fmt.Println(string(data))
+	fmt.Println(config.Token)
+
+	redacted, _ := json.Marshal(opt.Secret[string](config.Token))
+	fmt.Println(string(redacted))
+	// Output: {"token":"hunter2"}
+	// Some[string](REDACTED)
+	// "REDACTED"
+}
+
+func TestRevealedSecretLogValue(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buffer, nil))
+	logger.Info("login", "token", opt.RevealedSecret[string](opt.Conceal(opt.Some("hunter2"))))
+
+	if strings.Contains(buffer.String(), "hunter2") {
+		t.Fatalf("secret leaked into log: %s", buffer.String())
+	}
+}
+
+func TestRevealedSecretIdentity(t *testing.T) {
+	err := quick.Check(func(ser opt.T[string]) bool {
+		data, err := json.Marshal(opt.RevealedSecret[string](opt.Conceal(ser)))
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		var de opt.RevealedSecret[string]
+
+		err = json.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return de.Reveal() == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSecretLogValue(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger := slog.New(slog.NewTextHandler(&buffer, nil))
+	logger.Info("login", "password", opt.Conceal(opt.Some("hunter2")))
+
+	if strings.Contains(buffer.String(), "hunter2") {
+		t.Fatalf("secret leaked into log: %s", buffer.String())
+	}
+}
@@ -0,0 +1,128 @@
+package opt
+
+import "encoding/json"
+
+// ToSlice returns a slice containing the wrapped value if present,
+// and an empty slice otherwise.
+//
+// Inverse of [FromSlice] for slices with at most one element.
+func (t T[V]) ToSlice() []V {
+	value, present := t.Unwrap()
+	if !present {
+		return []V{}
+	}
+
+	return []V{value}
+}
+
+// FromSlice creates a new option containing the first element of the slice,
+// or an empty option if the slice is empty.
+//
+// Inverse of [T.ToSlice].
+func FromSlice[V any](values []V) T[V] {
+	if len(values) == 0 {
+		return None[V]()
+	}
+
+	return Some(values[0])
+}
+
+// Sequence returns an option containing the wrapped values of all options,
+// which is only present if every option is present.
+func Sequence[V any](opts []T[V]) T[[]V] {
+	return Traverse(opts, func(t T[V]) T[V] { return t })
+}
+
+// Traverse applies fn to every value, returning an option of the results,
+// which is only present if every result is present.
+//
+// Traverse stops calling fn after the first empty result.
+func Traverse[V, W any](values []V, fn func(V) T[W]) T[[]W] {
+	results := make([]W, 0, len(values))
+
+	for _, value := range values {
+		result, present := fn(value).Unwrap()
+		if !present {
+			return None[[]W]()
+		}
+
+		results = append(results, result)
+	}
+
+	return Some(results)
+}
+
+// Values returns the wrapped values of all present options,
+// skipping empty ones.
+func Values[V any](opts []T[V]) []V {
+	values, _ := PartitionPresent(opts)
+
+	return values
+}
+
+// CountPresent returns the number of present options.
+func CountPresent[V any](opts []T[V]) int {
+	count := 0
+
+	for _, t := range opts {
+		if t.present {
+			count++
+		}
+	}
+
+	return count
+}
+
+// PartitionPresent returns the wrapped values of all present options,
+// and the number of empty options.
+func PartitionPresent[V any](opts []T[V]) ([]V, int) {
+	values := make([]V, 0, len(opts))
+
+	for _, t := range opts {
+		value, present := t.Unwrap()
+		if present {
+			values = append(values, value)
+		}
+	}
+
+	return values, len(opts) - len(values)
+}
+
+// Slice is a slice of options, that omits empty options when marshalled to JSON,
+// e.g. for sparse arrays sent to APIs that reject nulls.
+//
+// To encode empty options as null instead, use [NullSlice].
+type Slice[V any] []T[V]
+
+// NullSlice is a slice of options, that encodes empty options as null when marshalled to JSON,
+// keeping the positions of all elements, like a plain []T[V].
+type NullSlice[V any] []T[V]
+
+// JSON Marshalling.
+var (
+	_ json.Marshaler = Slice[any]{}
+	_ json.Marshaler = NullSlice[any]{}
+)
+
+// MarshalJSON implements [json.Marshaler].
+func (s Slice[V]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	present := make([]T[V], 0, CountPresent(s))
+
+	for _, t := range s {
+		if t.present {
+			present = append(present, t)
+		}
+	}
+
+	// marshal the options themselves, to encode elements like a plain []T[V].
+	return json.Marshal(present)
+}
+
+// MarshalJSON implements [json.Marshaler].
+func (s NullSlice[V]) MarshalJSON() ([]byte, error) {
+	return json.Marshal([]T[V](s))
+}
@@ -0,0 +1,131 @@
+package opt_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_ToSlice() {
+	for _, value := range opt.Some("hello").ToSlice() {
+		fmt.Println(value)
+	}
+
+	for _, value := range opt.None[string]().ToSlice() {
+		fmt.Println(value)
+	}
+	// Output: hello
+}
+
+func ExampleFromSlice() {
+	fmt.Println(opt.FromSlice([]string{"first", "second"}))
+	fmt.Println(opt.FromSlice([]string{}))
+	// Output: Some[string](first)
+	// None[string]()
+}
+
+func TestFromSliceIdentity(t *testing.T) {
+	err := quick.Check(func(input opt.T[string]) bool {
+		return opt.Equal(input, opt.FromSlice(input.ToSlice()))
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func ExampleSequence() {
+	fmt.Println(opt.Sequence([]opt.String{opt.Some("a"), opt.Some("b")}))
+	fmt.Println(opt.Sequence([]opt.String{opt.Some("a"), opt.None[string]()}))
+	// Output: Some[[]string]([a b])
+	// None[[]string]()
+}
+
+func ExampleTraverse() {
+	parse := func(s string) opt.T[int] {
+		value, err := strconv.Atoi(s)
+		if err != nil {
+			return opt.None[int]()
+		}
+
+		return opt.Some(value)
+	}
+
+	fmt.Println(opt.Traverse([]string{"1", "2", "3"}, parse))
+	fmt.Println(opt.Traverse([]string{"1", "two", "3"}, parse))
+	// Output: Some[[]int]([1 2 3])
+	// None[[]int]()
+}
+
+func ExampleValues() {
+	samples := []opt.T[int]{opt.Some(1), opt.None[int](), opt.Some(3)}
+
+	fmt.Println(opt.Values(samples), opt.CountPresent(samples))
+	// Output: [1 3] 2
+}
+
+func ExamplePartitionPresent() {
+	samples := []opt.T[int]{opt.Some(1), opt.None[int](), opt.Some(3)}
+	values, empty := opt.PartitionPresent(samples)
+
+	fmt.Println(values, empty)
+	// Output: [1 3] 1
+}
+
+func TestPartitionPresentCounts(t *testing.T) {
+	err := quick.Check(func(input []opt.T[string]) bool {
+		values, empty := opt.PartitionPresent(input)
+
+		return len(values) == opt.CountPresent(input) &&
+			len(values)+empty == len(input)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func ExampleSlice() {
+	samples := []opt.T[int]{opt.Some(1), opt.None[int](), opt.Some(3)}
+
+	sparse, _ := json.Marshal(opt.Slice[int](samples))
+	nulls, _ := json.Marshal(opt.NullSlice[int](samples))
+
+	fmt.Println(string(sparse))
+	fmt.Println(string(nulls))
+	// Output: [1,3]
+	// [1,null,3]
+}
+
+func TestSliceMarshalElements(t *testing.T) {
+	samples := []opt.T[[]byte]{opt.Some([]byte(nil)), opt.None[[]byte](), opt.Some([]byte("go"))}
+
+	sparse, err := json.Marshal(opt.Slice[[]byte](samples))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plain, err := json.Marshal([]opt.T[[]byte]{samples[0], samples[2]})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(sparse) != string(plain) {
+		t.Fatalf("expected elements encoded like []opt.T[V] %s, got %s", plain, sparse)
+	}
+}
+
+func TestNullSliceMarshalJSON(t *testing.T) {
+	data, err := json.Marshal(struct {
+		Samples opt.NullSlice[int] `json:"samples"`
+	}{Samples: opt.NullSlice[int]{opt.Some(1), opt.None[int](), opt.Some(3)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != `{"samples":[1,null,3]}` {
+		t.Fatalf("expected %s, got %s", `{"samples":[1,null,3]}`, data)
+	}
+}
@@ -0,0 +1,20 @@
+module github.com/lukasngl/opt/sonic
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/bytedance/sonic v1.15.4
+	github.com/lukasngl/opt v0.0.0
+)
+
+require (
+	github.com/bytedance/gopkg v0.1.3 // indirect
+	github.com/bytedance/sonic/loader v0.5.2 // indirect
+	github.com/cloudwego/base64x v0.1.6 // indirect
+	github.com/klauspost/cpuid/v2 v2.2.9 // indirect
+	github.com/twitchyliquid64/golang-asm v0.15.1 // indirect
+	golang.org/x/arch v0.0.0-20210923205945-b76863e36670 // indirect
+	golang.org/x/sys v0.22.0 // indirect
+)
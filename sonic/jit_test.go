@@ -0,0 +1,69 @@
+//go:build amd64 || arm64
+
+package sonic_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+	"testing/quick"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/lukasngl/opt"
+)
+
+// sonic's JIT is only used on amd64 and arm64, falling back to encoding/json otherwise.
+// Its faster configs skip validating and compacting the output of json.Marshaler,
+// and do not escape HTML, thus the output is only compared semantically.
+func TestJIT(t *testing.T) {
+	for name, api := range map[string]sonic.API{
+		"default": sonic.ConfigDefault,
+		"fastest": sonic.ConfigFastest,
+	} {
+		t.Run(name, func(t *testing.T) {
+			err := quick.Check(func(ser User) bool {
+				var de, std any
+
+				data, err := api.Marshal(ser)
+				if err != nil {
+					t.Log(err.Error())
+					return false
+				}
+
+				expected, err := json.Marshal(ser)
+				if err != nil {
+					t.Log(err.Error())
+					return false
+				}
+
+				if json.Unmarshal(data, &de) != nil || json.Unmarshal(expected, &std) != nil ||
+					!reflect.DeepEqual(de, std) {
+					t.Logf("sonic: %s", data)
+					t.Logf("encoding/json: %s", expected)
+
+					return false
+				}
+
+				var user User
+
+				err = api.Unmarshal(data, &user)
+				if err != nil {
+					t.Log(err.Error())
+					return false
+				}
+
+				// a nested empty option is indistinguishable from an empty option
+				if ser.Verified.IsPresent() && !opt.Flatten(ser.Verified).IsPresent() {
+					ser.Verified = opt.None[opt.T[bool]]()
+				}
+
+				return fmt.Sprint(user) == fmt.Sprint(ser)
+			}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
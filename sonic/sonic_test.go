@@ -0,0 +1,64 @@
+package sonic_test
+
+import (
+	"encoding/json"
+	"testing"
+	"testing/quick"
+
+	"github.com/bytedance/sonic"
+
+	"github.com/lukasngl/opt"
+)
+
+type User struct {
+	Name     opt.T[string]            `json:"name"`
+	Email    opt.T[string]            `json:"email,omitzero"`
+	Age      opt.T[int]               `json:"age"`
+	Labels   opt.T[map[string]string] `json:"labels"`
+	Verified opt.T[opt.T[bool]]       `json:"verified"`
+}
+
+// TestStd verifies, that sonic's std compatible config produces
+// exactly the output of encoding/json.
+func TestStd(t *testing.T) {
+	err := quick.Check(func(name, email opt.T[string], age opt.T[int], labels opt.T[map[string]string]) bool {
+		ser := User{Name: name, Email: email, Age: age, Labels: labels}
+
+		data, err := sonic.ConfigStd.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		std, err := json.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if string(data) != string(std) {
+			t.Logf("sonic: %s", data)
+			t.Logf("encoding/json: %s", std)
+
+			return false
+		}
+
+		return true
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUnmarshalWhitespace(t *testing.T) {
+	user := User{Name: opt.Some("gopher"), Age: opt.Some(42)}
+
+	err := sonic.Unmarshal([]byte(`{ "name" :  null , "age" :  7 , "verified" : null }`), &user)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if user.Name.IsPresent() || !opt.Contains(user.Age, 7) || user.Verified.IsPresent() {
+		t.Fatalf("unexpected user %v", user)
+	}
+}
@@ -0,0 +1,6 @@
+// Package stream provides lazy combinators over [iter.Seq],
+// that integrate with [opt.T].
+//
+// The package requires go1.23 for range-over-func iterators,
+// while the opt package itself requires go1.22.
+package stream
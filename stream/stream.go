@@ -0,0 +1,49 @@
+//go:build go1.23
+
+package stream
+
+import (
+	"iter"
+
+	"github.com/lukasngl/opt"
+)
+
+// Map returns a sequence of fn applied to every element of seq.
+func Map[V, W any](seq iter.Seq[V], fn func(V) W) iter.Seq[W] {
+	return func(yield func(W) bool) {
+		for value := range seq {
+			if !yield(fn(value)) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMap returns a sequence of the wrapped values of fn applied to every element of seq,
+// skipping empty results.
+func FilterMap[V, W any](seq iter.Seq[V], fn func(V) opt.T[W]) iter.Seq[W] {
+	return Flatten(Map(seq, fn))
+}
+
+// Flatten returns a sequence of the wrapped values of all present options in seq,
+// skipping empty ones.
+func Flatten[V any](seq iter.Seq[opt.T[V]]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for t := range seq {
+			value, present := t.Unwrap()
+			if present && !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// First returns an option containing the first element of seq,
+// or an empty option if seq is empty.
+func First[V any](seq iter.Seq[V]) opt.T[V] {
+	for value := range seq {
+		return opt.Some(value)
+	}
+
+	return opt.None[V]()
+}
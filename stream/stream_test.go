@@ -0,0 +1,43 @@
+//go:build go1.23
+
+package stream_test
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/stream"
+)
+
+func ExampleFilterMap() {
+	inputs := slices.Values([]string{"1", "two", "3"})
+	parsed := stream.FilterMap(inputs, func(s string) opt.T[int] {
+		return opt.FromErr(strconv.Atoi(s))
+	})
+
+	fmt.Println(slices.Collect(parsed))
+	// Output: [1 3]
+}
+
+func ExampleFlatten() {
+	samples := slices.Values([]opt.T[int]{opt.Some(1), opt.None[int](), opt.Some(3)})
+
+	fmt.Println(slices.Collect(stream.Flatten(samples)))
+	// Output: [1 3]
+}
+
+func ExampleFirst() {
+	fmt.Println(stream.First(slices.Values([]string{"a", "b"})))
+	fmt.Println(stream.First(slices.Values([]string{})))
+	// Output: Some[string](a)
+	// None[string]()
+}
+
+func ExampleMap() {
+	lengths := stream.Map(slices.Values([]string{"a", "bb"}), func(s string) int { return len(s) })
+
+	fmt.Println(slices.Collect(lengths))
+	// Output: [1 2]
+}
@@ -0,0 +1,181 @@
+package opt
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// Text Marshalling und Unmarshalling.
+var (
+	_ encoding.TextMarshaler   = T[any]{}
+	_ encoding.TextUnmarshaler = &T[any]{}
+)
+
+// MarshalText implements [encoding.TextMarshaler].
+//
+// Empty options are encoded as empty text, while present values are encoded as follows:
+//
+//  1. If the value implements [encoding.TextMarshaler], it is used,
+//     even if implemented by *V, e.g. [time.Time] or [net/netip.Addr],
+//  2. otherwise strings and byte slices are used as is,
+//     while booleans and numbers are formatted using [strconv].
+//
+// Thus present values encoded as empty text, like empty strings, are decoded as empty options,
+// use [EmptyText] if empty text is meaningful.
+func (t T[V]) MarshalText() ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return []byte{}, nil
+	}
+
+	return marshalText(value)
+}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+//
+// Empty text is decoded as an empty option,
+// otherwise the value is decoded as the inverse of [T.MarshalText],
+// delegating to [encoding.TextUnmarshaler] implemented by *V.
+func (t *T[V]) UnmarshalText(data []byte) error {
+	if len(data) == 0 {
+		*t = None[V]()
+
+		return nil
+	}
+
+	return t.unmarshalPresentText(data)
+}
+
+// unmarshalPresentText decodes the text as present value, even if it is empty.
+func (t *T[V]) unmarshalPresentText(data []byte) error {
+	var value V
+
+	err := unmarshalText(data, &value)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
+
+// EmptyText is an option, that decodes empty text as a present value,
+// for types whose empty text is meaningful, e.g. strings.
+//
+// As empty options are still encoded as empty text,
+// they are not preserved, but decoded as present zero values.
+type EmptyText[V any] struct {
+	T[V]
+}
+
+// Text Unmarshalling.
+var _ encoding.TextUnmarshaler = &EmptyText[any]{}
+
+// UnmarshalText implements [encoding.TextUnmarshaler].
+func (t *EmptyText[V]) UnmarshalText(data []byte) error {
+	return t.unmarshalPresentText(data)
+}
+
+func marshalText[V any](value V) ([]byte, error) {
+	return marshalTextValue(reflect.ValueOf(&value).Elem())
+}
+
+func marshalTextValue(rv reflect.Value) ([]byte, error) {
+	return appendTextValue([]byte{}, rv)
+}
+
+// appendTextValue appends the text encoding of the value to b, see [T.MarshalText].
+func appendTextValue(b []byte, rv reflect.Value) ([]byte, error) {
+	marshaler, ok := addressable(rv).Addr().Interface().(encoding.TextMarshaler)
+	if !ok {
+		marshaler, ok = rv.Interface().(encoding.TextMarshaler)
+	}
+
+	if ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return nil, err
+		}
+
+		return append(b, text...), nil
+	}
+
+	switch rv.Kind() {
+	case reflect.String:
+		return append(b, rv.String()...), nil
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, fmt.Errorf("opt: cannot marshal %s as text", rv.Type())
+		}
+
+		return append(b, rv.Bytes()...), nil
+	case reflect.Bool:
+		return strconv.AppendBool(b, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(b, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(b, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(b, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	default:
+		return nil, fmt.Errorf("opt: cannot marshal %s as text", rv.Type())
+	}
+}
+
+func unmarshalText[V any](data []byte, value *V) error {
+	return unmarshalTextValue(data, reflect.ValueOf(value).Elem())
+}
+
+func unmarshalTextValue(data []byte, rv reflect.Value) error {
+	if unmarshaler, ok := rv.Addr().Interface().(encoding.TextUnmarshaler); ok {
+		return unmarshaler.UnmarshalText(data)
+	}
+
+	text := string(data)
+
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(text)
+	case reflect.Slice:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("opt: cannot unmarshal text into %s", rv.Type())
+		}
+
+		rv.SetBytes([]byte(text))
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return err
+		}
+
+		rv.SetBool(parsed)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		parsed, err := strconv.ParseUint(text, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetUint(parsed)
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+
+		rv.SetFloat(parsed)
+	default:
+		return fmt.Errorf("opt: cannot unmarshal text into %s", rv.Type())
+	}
+
+	return nil
+}
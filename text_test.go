@@ -0,0 +1,152 @@
+package opt_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_MarshalText() {
+	addr, _ := opt.Some(netip.MustParseAddr("::1")).MarshalText()
+	port, _ := opt.Some(8080).MarshalText()
+	none, _ := opt.None[int]().MarshalText()
+
+	fmt.Printf("%q %q %q", addr, port, none)
+	// Output: "::1" "8080" ""
+}
+
+func ExampleT_UnmarshalText() {
+	var addr opt.T[netip.Addr]
+
+	_ = addr.UnmarshalText([]byte("127.0.0.1"))
+	fmt.Println(addr)
+
+	_ = addr.UnmarshalText([]byte{})
+	fmt.Println(addr)
+	// Output: Some[netip.Addr](127.0.0.1)
+	// None[netip.Addr]()
+}
+
+func TestTextUnsupported(t *testing.T) {
+	_, err := opt.Some([]int{1}).MarshalText()
+	if err == nil {
+		t.Error("expected error marshaling a slice as text")
+	}
+
+	var value opt.T[[]int]
+
+	err = value.UnmarshalText([]byte("1"))
+	if err == nil {
+		t.Error("expected error unmarshaling text into a slice")
+	}
+}
+
+func textIdentity[V comparable](t *testing.T) {
+	t.Helper()
+
+	err := quick.Check(func(input opt.T[V]) bool {
+		var output opt.T[V]
+
+		data, err := input.MarshalText()
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = output.UnmarshalText(data)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return opt.Equal(input, output)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTextIdentity(t *testing.T) {
+	t.Run("bool", textIdentity[bool])
+	t.Run("int8", textIdentity[int8])
+	t.Run("int64", textIdentity[int64])
+	t.Run("uint32", textIdentity[uint32])
+	t.Run("float32", textIdentity[float32])
+	t.Run("float64", textIdentity[float64])
+}
+
+// Code implements text marshaling on its pointer.
+type Code struct {
+	Value int
+}
+
+func (c *Code) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("C-%d", c.Value)), nil
+}
+
+func (c *Code) UnmarshalText(data []byte) error {
+	_, err := fmt.Sscanf(string(data), "C-%d", &c.Value)
+
+	return err
+}
+
+func TestTextPointerReceiver(t *testing.T) {
+	data, err := opt.Some(Code{Value: 7}).MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(data) != "C-7" {
+		t.Errorf("expected text marshaler of *Code to be used, got %q", data)
+	}
+
+	var code opt.T[Code]
+
+	err = code.UnmarshalText(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if code != opt.Some(Code{Value: 7}) {
+		t.Errorf("expected Some(C-7), got %v", code)
+	}
+}
+
+func ExampleEmptyText() {
+	var (
+		nickname opt.T[string]
+		comment  opt.EmptyText[string]
+	)
+
+	_ = nickname.UnmarshalText([]byte{})
+	_ = comment.UnmarshalText([]byte{})
+
+	fmt.Printf("%v %v\n", nickname, comment)
+	// Output: None[string]() Some[string]()
+}
+
+func TestEmptyTextIdentity(t *testing.T) {
+	err := quick.Check(func(input string) bool {
+		var output opt.EmptyText[string]
+
+		data, err := opt.Some(input).MarshalText()
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = output.UnmarshalText(data)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return output.T == opt.Some(input)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
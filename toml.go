@@ -0,0 +1,150 @@
+package opt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TOML Marshalling und Unmarshalling.
+//
+// The interfaces are declared locally,
+// such that the package does not depend on any TOML library.
+var (
+	_ interface{ MarshalTOML() ([]byte, error) } = T[any]{}
+	_ interface{ UnmarshalTOML(any) error }      = &T[any]{}
+)
+
+// ErrEmptyTOML is returned when marshalling an empty option to TOML,
+// which has no representation for null.
+var ErrEmptyTOML = errors.New("opt: cannot marshal an empty option as TOML, use the omitempty struct tag")
+
+// MarshalTOML implements the Marshaler interface of github.com/BurntSushi/toml,
+// which is the unstable.Marshaler interface of github.com/pelletier/go-toml/v2.
+//
+// As TOML has no null, empty options must be omitted using the omitempty struct tag,
+// otherwise [ErrEmptyTOML] is returned.
+//
+// Present values are encoded using [encoding/json] and converted to TOML,
+// thus nested struct fields are named according to their json struct tags.
+// [time.Time] values are encoded as TOML date-times instead.
+func (t T[V]) MarshalTOML() ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return nil, ErrEmptyTOML
+	}
+
+	if instant, ok := any(value).(time.Time); ok {
+		return []byte(instant.Format(time.RFC3339Nano)), nil
+	}
+
+	data, err := json.Marshal(&value)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var buffer bytes.Buffer
+
+	err = jsonToTOML(&buffer, decoder)
+	if err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// UnmarshalTOML implements the Unmarshaler interface of github.com/BurntSushi/toml.
+//
+// The decoded TOML value is converted to the wrapped type using [encoding/json],
+// symmetric to [T.MarshalTOML].
+//
+// Note that github.com/pelletier/go-toml/v2 uses a different Unmarshaler interface,
+// thus it only decodes options from TOML strings, via [T.UnmarshalText].
+func (t *T[V]) UnmarshalTOML(data any) error {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+
+	var value V
+
+	err = json.Unmarshal(encoded, &value)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
+
+// jsonToTOML converts the next JSON value of the decoder into a TOML inline value.
+func jsonToTOML(buffer *bytes.Buffer, decoder *json.Decoder) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	switch token := token.(type) {
+	case json.Delim:
+		return jsonToTOMLComposite(buffer, decoder, token)
+	case string:
+		// JSON strings are valid TOML basic strings.
+		quoted, _ := json.Marshal(token)
+		buffer.Write(quoted)
+	case json.Number:
+		buffer.WriteString(token.String())
+	case bool:
+		buffer.WriteString(strconv.FormatBool(token))
+	case nil:
+		return fmt.Errorf("opt: cannot marshal null as TOML")
+	}
+
+	return nil
+}
+
+// jsonToTOMLComposite converts the JSON array or object opened by delim into
+// a TOML inline array or table.
+func jsonToTOMLComposite(buffer *bytes.Buffer, decoder *json.Decoder, delim json.Delim) error {
+	isTable := delim == '{'
+
+	buffer.WriteByte(byte(delim))
+
+	for i := 0; decoder.More(); i++ {
+		if i > 0 {
+			buffer.WriteString(", ")
+		}
+
+		if isTable {
+			key, err := decoder.Token()
+			if err != nil {
+				return err
+			}
+
+			quoted, _ := json.Marshal(key)
+			buffer.Write(quoted)
+			buffer.WriteString(" = ")
+		}
+
+		err := jsonToTOML(buffer, decoder)
+		if err != nil {
+			return err
+		}
+	}
+
+	// consume the closing delimiter.
+	closing, err := decoder.Token()
+	if err != nil {
+		return err
+	}
+
+	buffer.WriteString(closing.(json.Delim).String())
+
+	return nil
+}
@@ -0,0 +1,11 @@
+module github.com/lukasngl/opt/toml
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/BurntSushi/toml v1.6.0
+	github.com/lukasngl/opt v0.0.0
+	github.com/pelletier/go-toml/v2 v2.4.3
+)
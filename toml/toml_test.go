@@ -0,0 +1,146 @@
+package toml_test
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	pelletier "github.com/pelletier/go-toml/v2"
+
+	"github.com/lukasngl/opt"
+)
+
+// Server is wrapped in an option, thus it is converted via encoding/json,
+// which requires json struct tags.
+type Server struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+type Config struct {
+	Name    opt.String       `toml:"name,omitempty"`
+	Port    opt.T[int]       `toml:"port,omitempty"`
+	Debug   opt.Bool         `toml:"debug,omitempty"`
+	Tags    opt.T[[]string]  `toml:"tags,omitempty"`
+	Created opt.T[time.Time] `toml:"created,omitempty"`
+	Server  opt.T[Server]    `toml:"server,omitempty"`
+}
+
+var config = Config{
+	Name:    opt.Some("gopher"),
+	Port:    opt.Some(8080),
+	Debug:   opt.None[bool](),
+	Tags:    opt.Some([]string{"a", "b"}),
+	Created: opt.Some(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)),
+	Server:  opt.Some(Server{Host: "localhost", Port: 443}),
+}
+
+const want = `name = "gopher"
+port = 8080
+tags = ["a", "b"]
+created = 2024-01-01T00:00:00Z
+server = {"host" = "localhost", "port" = 443}
+`
+
+func TestBurntSushiMarshal(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := toml.NewEncoder(&buffer).Encode(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if buffer.String() != want {
+		t.Fatalf("expected:\n%s\ngot:\n%s", want, buffer.String())
+	}
+}
+
+func TestPelletierMarshal(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := pelletier.NewEncoder(&buffer).EnableMarshalerInterface().Encode(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if buffer.String() != want {
+		t.Fatalf("expected:\n%s\ngot:\n%s", want, buffer.String())
+	}
+}
+
+func TestMarshalEmptyWithoutOmitempty(t *testing.T) {
+	var buffer bytes.Buffer
+
+	err := toml.NewEncoder(&buffer).Encode(struct {
+		Name opt.String `toml:"name"`
+	}{})
+	if !errors.Is(err, opt.ErrEmptyTOML) {
+		t.Fatalf("expected %v, got %v", opt.ErrEmptyTOML, err)
+	}
+}
+
+func TestBurntSushiUnmarshal(t *testing.T) {
+	var decoded Config
+
+	_, err := toml.Decode(want, &decoded)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if decoded.Debug.IsPresent() {
+		t.Errorf("expected absent key to be empty, got %s", decoded.Debug)
+	}
+
+	if decoded.Name != config.Name || decoded.Port != config.Port || decoded.Server != config.Server ||
+		decoded.Tags.String() != config.Tags.String() || !decoded.Created.Must().Equal(config.Created.Must()) {
+		t.Fatalf("expected %+v, got %+v", config, decoded)
+	}
+}
+
+type Thing struct {
+	Bool    opt.Bool     `toml:"bool,omitempty"`
+	Float64 opt.Float64  `toml:"float64,omitempty"`
+	Int64   opt.T[int64] `toml:"int64,omitempty"`
+	String  opt.String   `toml:"string,omitempty"`
+	Uint16  opt.Uint16   `toml:"uint16,omitempty"`
+	Struct  opt.T[struct {
+		Test  string
+		Test2 int
+	}] `toml:"struct,omitempty"`
+}
+
+func TestBurntSushiMarshalIdentity(t *testing.T) {
+	err := quick.Check(func(ser Thing) bool {
+		var (
+			buffer bytes.Buffer
+			de     Thing
+		)
+
+		err := toml.NewEncoder(&buffer).Encode(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		_, err = toml.Decode(buffer.String(), &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if de != ser {
+			t.Logf("ser: %#v", ser)
+			t.Logf("de: %#v", de)
+			t.Log(strings.TrimSpace(buffer.String()))
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
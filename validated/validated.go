@@ -0,0 +1,146 @@
+// Package validated provides a type representing either a value or a list of errors,
+// that accumulates errors when combined, instead of stopping at the first one.
+package validated
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/lukasngl/opt"
+)
+
+// T represents a validated value, i.e. either a value or a non-empty list of errors.
+type T[V any] struct {
+	v    V
+	errs []error
+}
+
+// Valid creates a new valid value.
+func Valid[V any](value V) T[V] {
+	return T[V]{
+		v:    value,
+		errs: nil,
+	}
+}
+
+// Invalid creates a new invalid value, that holds the given errors.
+//
+// Nil errors are discarded, and Invalid panics if no error remains.
+func Invalid[V any](errs ...error) T[V] {
+	nonNil := make([]error, 0, len(errs))
+
+	for _, err := range errs {
+		if err != nil {
+			nonNil = append(nonNil, err)
+		}
+	}
+
+	if len(nonNil) == 0 {
+		panic("called validated.Invalid without a non-nil error")
+	}
+
+	//nolint:exhaustruct
+	return T[V]{errs: nonNil}
+}
+
+// From creates a new validated value from a value and an error,
+// as commonly returned by functions.
+//
+// If the error is non-nil an invalid value is returned,
+// otherwise a valid value.
+func From[V any](value V, err error) T[V] {
+	if err != nil {
+		return Invalid[V](err)
+	}
+
+	return Valid(value)
+}
+
+// FromOpt creates a new validated value from an option,
+// using err if the option is empty, see [opt.T.OkOr].
+func FromOpt[V any](t opt.T[V], err error) T[V] {
+	return From(t.OkOr(err))
+}
+
+// IsValid returns whether the value is valid.
+func (t T[V]) IsValid() bool {
+	return len(t.errs) == 0
+}
+
+// Errors returns the errors of an invalid value, and nil otherwise.
+func (t T[V]) Errors() []error {
+	return t.errs
+}
+
+// Err returns the errors of an invalid value joined into one, see [errors.Join],
+// and nil otherwise.
+func (t T[V]) Err() error {
+	return errors.Join(t.errs...)
+}
+
+// Unwrap returns the value and the joined errors, see [T.Err].
+func (t T[V]) Unwrap() (V, error) {
+	if !t.IsValid() {
+		var zero V
+
+		return zero, t.Err()
+	}
+
+	return t.v, nil
+}
+
+// ToOpt returns an option containing the value if valid,
+// and an empty option otherwise, discarding the errors.
+func (t T[V]) ToOpt() opt.T[V] {
+	return opt.FromErr(t.Unwrap())
+}
+
+// String implements [fmt.Stringer].
+func (t T[V]) String() string {
+	if !t.IsValid() {
+		return fmt.Sprintf("Invalid[%T](%v)", t.v, t.errs)
+	}
+
+	return fmt.Sprintf("Valid[%T](%v)", t.v, t.v)
+}
+
+// Map applies fn to the value if valid,
+// and propagates the errors otherwise.
+func Map[V, W any](t T[V], fn func(V) W) T[W] {
+	if !t.IsValid() {
+		//nolint:exhaustruct
+		return T[W]{errs: t.errs}
+	}
+
+	return Valid(fn(t.v))
+}
+
+// Apply applies the wrapped function to the wrapped value if both are valid,
+// and returns the errors of both otherwise.
+func Apply[A, B any](fn T[func(A) B], a T[A]) T[B] {
+	if !fn.IsValid() || !a.IsValid() {
+		return Invalid[B](append(append([]error{}, fn.errs...), a.errs...)...)
+	}
+
+	return Valid(fn.v(a.v))
+}
+
+// Combine applies fn to both values if both are valid,
+// and returns the errors of both otherwise.
+func Combine[A, B, R any](a T[A], b T[B], fn func(A, B) R) T[R] {
+	curried := Map(a, func(a A) func(B) R {
+		return func(b B) R { return fn(a, b) }
+	})
+
+	return Apply(curried, b)
+}
+
+// Combine3 applies fn to all values if all are valid,
+// and returns the errors of all otherwise.
+func Combine3[A, B, C, R any](a T[A], b T[B], c T[C], fn func(A, B, C) R) T[R] {
+	curried := Combine(a, b, func(a A, b B) func(C) R {
+		return func(c C) R { return fn(a, b, c) }
+	})
+
+	return Apply(curried, c)
+}
@@ -0,0 +1,56 @@
+package validated_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+	"github.com/lukasngl/opt/validated"
+)
+
+type Signup struct {
+	Name  string
+	Email string
+	Age   int
+}
+
+func ExampleCombine3() {
+	validate := func(name, email opt.String, age opt.T[int]) validated.T[Signup] {
+		return validated.Combine3(
+			validated.FromOpt(name, errors.New("name is required")),
+			validated.FromOpt(email, errors.New("email is required")),
+			validated.FromOpt(age, errors.New("age is required")),
+			func(name, email string, age int) Signup {
+				return Signup{Name: name, Email: email, Age: age}
+			},
+		)
+	}
+
+	fmt.Println(validate(opt.Some("gopher"), opt.Some("gopher@go.dev"), opt.Some(14)))
+	fmt.Println(validate(opt.Some("gopher"), opt.None[string](), opt.None[int]()))
+	// Output: Valid[validated_test.Signup]({gopher gopher@go.dev 14})
+	// Invalid[validated_test.Signup]([email is required age is required])
+}
+
+func ExampleT_Unwrap() {
+	_, err := validated.Invalid[int](errors.New("too small"), errors.New("not even")).Unwrap()
+
+	fmt.Println(err)
+	// Output: too small
+	// not even
+}
+
+func TestFromOptIdentity(t *testing.T) {
+	errMissing := errors.New("missing")
+
+	err := quick.Check(func(input opt.T[string]) bool {
+		output := validated.FromOpt(input, errMissing).ToOpt()
+
+		return opt.Equal(input, output)
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,171 @@
+package opt
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"strings"
+)
+
+// textOption is implemented by pointers to options, used to encode their fields.
+type textOption interface {
+	IsPresent() bool
+	MarshalText() ([]byte, error)
+	unmarshalPresentText(data []byte) error
+}
+
+var textOptionType = reflect.TypeOf((*textOption)(nil)).Elem()
+
+// EncodeValues encodes the exported fields of the struct v as [url.Values],
+// e.g. for building query strings.
+//
+// Empty options are omitted, while present options and other fields are encoded as text,
+// see [T.MarshalText]. Slices are encoded as repeated values.
+// The key is taken from the url tag, as known from github.com/google/go-querystring,
+// defaulting to the field name. Fields tagged with "-" are skipped.
+func EncodeValues(v any) (url.Values, error) {
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("opt: cannot encode %T as values", v)
+	}
+
+	rv = addressable(rv)
+
+	values := url.Values{}
+
+	for i := 0; i < rv.NumField(); i++ {
+		key, ok := valuesKey(rv.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		field := rv.Field(i)
+
+		if option, ok := fieldOption(field); ok {
+			if !option.IsPresent() {
+				continue
+			}
+
+			text, err := option.MarshalText()
+			if err != nil {
+				return nil, fmt.Errorf("opt: field %s: %w", key, err)
+			}
+
+			values.Add(key, string(text))
+
+			continue
+		}
+
+		err := encodeValue(values, key, field)
+		if err != nil {
+			return nil, fmt.Errorf("opt: field %s: %w", key, err)
+		}
+	}
+
+	return values, nil
+}
+
+// DecodeValues decodes the given values into the struct pointed to by v,
+// as the inverse of [EncodeValues].
+//
+// Options of absent keys are left untouched, while present keys are decoded as present options,
+// even if the value is empty, e.g. for the query string "?q=".
+func DecodeValues(values url.Values, v any) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Pointer || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("opt: cannot decode values into %T", v)
+	}
+
+	rv = rv.Elem()
+
+	for i := 0; i < rv.NumField(); i++ {
+		key, ok := valuesKey(rv.Type().Field(i))
+		if !ok {
+			continue
+		}
+
+		texts, present := values[key]
+		if !present || len(texts) == 0 {
+			continue
+		}
+
+		err := decodeValue(texts, rv.Field(i))
+		if err != nil {
+			return fmt.Errorf("opt: field %s: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func valuesKey(field reflect.StructField) (string, bool) {
+	if !field.IsExported() {
+		return "", false
+	}
+
+	name, _, _ := strings.Cut(field.Tag.Get("url"), ",")
+
+	switch name {
+	case "-":
+		return "", false
+	case "":
+		return field.Name, true
+	default:
+		return name, true
+	}
+}
+
+func fieldOption(field reflect.Value) (textOption, bool) {
+	if !field.CanAddr() || !field.Addr().Type().Implements(textOptionType) {
+		return nil, false
+	}
+
+	option, ok := field.Addr().Interface().(textOption)
+
+	return option, ok
+}
+
+func encodeValue(values url.Values, key string, field reflect.Value) error {
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		for i := 0; i < field.Len(); i++ {
+			err := encodeValue(values, key, field.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	text, err := marshalTextValue(field)
+	if err != nil {
+		return err
+	}
+
+	values.Add(key, string(text))
+
+	return nil
+}
+
+func decodeValue(texts []string, field reflect.Value) error {
+	if option, ok := fieldOption(field); ok {
+		return option.unmarshalPresentText([]byte(texts[0]))
+	}
+
+	if field.Kind() == reflect.Slice && field.Type().Elem().Kind() != reflect.Uint8 {
+		slice := reflect.MakeSlice(field.Type(), len(texts), len(texts))
+
+		for i, text := range texts {
+			err := decodeValue([]string{text}, slice.Index(i))
+			if err != nil {
+				return err
+			}
+		}
+
+		field.Set(slice)
+
+		return nil
+	}
+
+	return unmarshalTextValue([]byte(texts[0]), field)
+}
@@ -0,0 +1,91 @@
+package opt_test
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+type SearchParams struct {
+	Query  string           `url:"q"`
+	Page   opt.T[int]       `url:"page"`
+	Since  opt.T[time.Time] `url:"since"`
+	Tags   []string         `url:"tag"`
+	Cursor opt.T[string]    `url:"cursor"`
+	Debug  bool             `url:"-"`
+}
+
+func ExampleEncodeValues() {
+	values, _ := opt.EncodeValues(SearchParams{
+		Query: "gopher",
+		Page:  opt.Some(2),
+		Tags:  []string{"go", "generics"},
+	})
+
+	fmt.Println(values.Encode())
+	// Output: page=2&q=gopher&tag=go&tag=generics
+}
+
+func ExampleDecodeValues() {
+	values, _ := url.ParseQuery("q=gopher&page=2&cursor=&tag=go")
+
+	var params SearchParams
+
+	_ = opt.DecodeValues(values, &params)
+
+	fmt.Println(params.Query, params.Page, params.Since, params.Tags, params.Cursor)
+	// Output: gopher Some[int](2) None[time.Time]() [go] Some[string]()
+}
+
+func TestDecodeValuesInvalid(t *testing.T) {
+	var params SearchParams
+
+	err := opt.DecodeValues(url.Values{"page": {"two"}}, &params)
+	if err == nil {
+		t.Fatal("expected error decoding an invalid page")
+	}
+
+	err = opt.DecodeValues(url.Values{}, params)
+	if err == nil {
+		t.Fatal("expected error decoding into a non-pointer")
+	}
+}
+
+func TestValuesIdentity(t *testing.T) {
+	type Thing struct {
+		Int    opt.T[int]
+		Float  opt.T[float64]
+		String opt.T[string] `url:"string"`
+		Bool   bool
+	}
+
+	err := quick.Check(func(ser Thing) bool {
+		var de Thing
+
+		values, err := opt.EncodeValues(&ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = opt.DecodeValues(values, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if de != ser {
+			t.Logf("ser: %v", ser)
+			t.Logf("de: %v", de)
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
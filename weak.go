@@ -0,0 +1,27 @@
+//go:build go1.24
+
+package opt
+
+import "weak"
+
+// Weak is a weak reference to a value,
+// that reads as an empty option once the value has been garbage collected.
+//
+// The zero value is an empty option.
+type Weak[V any] struct {
+	p weak.Pointer[V]
+}
+
+// MakeWeak creates a new weak reference to the value referenced by the pointer,
+// which does not keep the value alive.
+//
+// If the pointer is nil, the weak reference is always empty.
+func MakeWeak[V any](value *V) Weak[V] {
+	return Weak[V]{p: weak.Make(value)}
+}
+
+// Get returns an option containing the referenced value if it is still reachable,
+// and an empty option otherwise.
+func (w Weak[V]) Get() T[V] {
+	return FromNillable(w.p.Value())
+}
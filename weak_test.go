@@ -0,0 +1,43 @@
+//go:build go1.24
+
+package opt_test
+
+import (
+	"fmt"
+	"runtime"
+	"testing"
+
+	"github.com/lukasngl/opt"
+)
+
+type Resource struct {
+	Name string
+	Size int
+}
+
+func ExampleMakeWeak() {
+	resource := &Resource{Name: "template", Size: 42}
+	ref := opt.MakeWeak(resource)
+
+	fmt.Println(ref.Get())
+	runtime.KeepAlive(resource)
+	// Output: Some[opt_test.Resource]({template 42})
+}
+
+func TestWeakCollected(t *testing.T) {
+	ref := opt.MakeWeak(&Resource{Name: "template", Size: 42})
+
+	runtime.GC()
+
+	if value := ref.Get(); value.IsPresent() {
+		t.Fatalf("expected collected value to be empty, got %s", value)
+	}
+}
+
+func TestWeakZero(t *testing.T) {
+	var ref opt.Weak[Resource]
+
+	if ref.Get().IsPresent() || opt.MakeWeak[Resource](nil).Get().IsPresent() {
+		t.Fatal("expected zero and nil weak references to be empty")
+	}
+}
@@ -0,0 +1,236 @@
+package opt
+
+import (
+	"encoding"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// AppendWire appends the wire encoding of the option to b, see [DecodeWire].
+//
+// The encoding consists of a presence byte, followed by the value if present:
+//
+//  1. booleans as a single byte, integers as (zig-zag) varints,
+//     floats and complex numbers as little-endian IEEE 754,
+//  2. strings and byte slices as a varint length followed by their bytes,
+//  3. other values as a varint length followed by their binary encoding, see [T.MarshalBinary].
+//
+// In contrast to [T.MarshalBinary], the encoding is self-delimiting,
+// thus options can be embedded in custom binary protocols and records.
+func (t T[V]) AppendWire(b []byte) ([]byte, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return append(b, binaryNone), nil
+	}
+
+	b = append(b, binarySome)
+
+	rv := reflect.ValueOf(&value).Elem()
+	if isWireScalar(rv.Type()) {
+		return appendWireScalar(b, rv), nil
+	}
+
+	payload, err := marshalBinary(value)
+	if err != nil {
+		return nil, err
+	}
+
+	b = binary.AppendUvarint(b, uint64(len(payload)))
+
+	return append(b, payload...), nil
+}
+
+// DecodeWire decodes an option from the beginning of data, as encoded by [T.AppendWire],
+// returning the number of bytes read.
+func DecodeWire[V any](data []byte) (T[V], int, error) {
+	if len(data) == 0 {
+		return None[V](), 0, fmt.Errorf("%w: missing presence byte", ErrInvalidBinary)
+	}
+
+	switch data[0] {
+	case binaryNone:
+		return None[V](), 1, nil
+	case binarySome:
+		var value V
+
+		n, err := decodeWireValue(data[1:], &value)
+		if err != nil {
+			return None[V](), 0, err
+		}
+
+		return Some(value), 1 + n, nil
+	default:
+		return None[V](), 0, fmt.Errorf("%w: unknown presence byte %#x", ErrInvalidBinary, data[0])
+	}
+}
+
+func decodeWireValue[V any](data []byte, value *V) (int, error) {
+	rv := reflect.ValueOf(value).Elem()
+	if isWireScalar(rv.Type()) {
+		return decodeWireScalar(data, rv)
+	}
+
+	size, n := binary.Uvarint(data)
+	if n <= 0 || size > uint64(len(data)-n) {
+		return 0, fmt.Errorf("%w: truncated value", ErrInvalidBinary)
+	}
+
+	err := unmarshalBinary(data[n:n+int(size)], value)
+	if err != nil {
+		return 0, err
+	}
+
+	return n + int(size), nil
+}
+
+// isWireScalar reports whether values of the type are encoded directly,
+// i.e. builtin kinds without their own binary encoding.
+func isWireScalar(t reflect.Type) bool {
+	if t.Implements(reflect.TypeOf((*encoding.BinaryMarshaler)(nil)).Elem()) ||
+		reflect.PointerTo(t).Implements(reflect.TypeOf((*encoding.BinaryUnmarshaler)(nil)).Elem()) {
+		return false
+	}
+
+	switch t.Kind() {
+	case reflect.Bool, reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128:
+		return true
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Uint8
+	default:
+		return false
+	}
+}
+
+func appendWireScalar(b []byte, rv reflect.Value) []byte {
+	switch rv.Kind() {
+	case reflect.Bool:
+		if rv.Bool() {
+			return append(b, 1)
+		}
+
+		return append(b, 0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return binary.AppendVarint(b, rv.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return binary.AppendUvarint(b, rv.Uint())
+	case reflect.Float32:
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(rv.Float())))
+	case reflect.Float64:
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(rv.Float()))
+	case reflect.Complex64:
+		b = binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(real(rv.Complex()))))
+
+		return binary.LittleEndian.AppendUint32(b, math.Float32bits(float32(imag(rv.Complex()))))
+	case reflect.Complex128:
+		b = binary.LittleEndian.AppendUint64(b, math.Float64bits(real(rv.Complex())))
+
+		return binary.LittleEndian.AppendUint64(b, math.Float64bits(imag(rv.Complex())))
+	case reflect.String:
+		b = binary.AppendUvarint(b, uint64(rv.Len()))
+
+		return append(b, rv.String()...)
+	default:
+		b = binary.AppendUvarint(b, uint64(rv.Len()))
+
+		return append(b, rv.Bytes()...)
+	}
+}
+
+func decodeWireScalar(data []byte, rv reflect.Value) (int, error) {
+	truncated := fmt.Errorf("%w: truncated %s", ErrInvalidBinary, rv.Type())
+
+	switch rv.Kind() {
+	case reflect.Bool:
+		if len(data) == 0 || data[0] > 1 {
+			return 0, truncated
+		}
+
+		rv.SetBool(data[0] == 1)
+
+		return 1, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, n := binary.Varint(data)
+		if n <= 0 {
+			return 0, truncated
+		}
+
+		if rv.OverflowInt(value) {
+			return 0, fmt.Errorf("%w: %d overflows %s", ErrInvalidBinary, value, rv.Type())
+		}
+
+		rv.SetInt(value)
+
+		return n, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		value, n := binary.Uvarint(data)
+		if n <= 0 {
+			return 0, truncated
+		}
+
+		if rv.OverflowUint(value) {
+			return 0, fmt.Errorf("%w: %d overflows %s", ErrInvalidBinary, value, rv.Type())
+		}
+
+		rv.SetUint(value)
+
+		return n, nil
+	case reflect.Float32:
+		if len(data) < 4 {
+			return 0, truncated
+		}
+
+		rv.SetFloat(float64(math.Float32frombits(binary.LittleEndian.Uint32(data))))
+
+		return 4, nil
+	case reflect.Float64:
+		if len(data) < 8 {
+			return 0, truncated
+		}
+
+		rv.SetFloat(math.Float64frombits(binary.LittleEndian.Uint64(data)))
+
+		return 8, nil
+	case reflect.Complex64:
+		if len(data) < 8 {
+			return 0, truncated
+		}
+
+		rv.SetComplex(complex128(complex(
+			math.Float32frombits(binary.LittleEndian.Uint32(data)),
+			math.Float32frombits(binary.LittleEndian.Uint32(data[4:])),
+		)))
+
+		return 8, nil
+	case reflect.Complex128:
+		if len(data) < 16 {
+			return 0, truncated
+		}
+
+		rv.SetComplex(complex(
+			math.Float64frombits(binary.LittleEndian.Uint64(data)),
+			math.Float64frombits(binary.LittleEndian.Uint64(data[8:])),
+		))
+
+		return 16, nil
+	default:
+		size, n := binary.Uvarint(data)
+		if n <= 0 || size > uint64(len(data)-n) {
+			return 0, truncated
+		}
+
+		payload := data[n : n+int(size)]
+
+		if rv.Kind() == reflect.String {
+			rv.SetString(string(payload))
+		} else {
+			rv.SetBytes(append([]byte{}, payload...))
+		}
+
+		return n + int(size), nil
+	}
+}
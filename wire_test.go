@@ -0,0 +1,148 @@
+package opt_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/lukasngl/opt"
+)
+
+func ExampleT_AppendWire() {
+	record, _ := opt.Some[int64](-2).AppendWire(nil)
+	record, _ = opt.None[string]().AppendWire(record)
+	record, _ = opt.Some("go").AppendWire(record)
+
+	fmt.Println(record)
+
+	id, n, _ := opt.DecodeWire[int64](record)
+	record = record[n:]
+	nickname, n, _ := opt.DecodeWire[string](record)
+	record = record[n:]
+	name, _, _ := opt.DecodeWire[string](record)
+
+	fmt.Println(id, nickname, name)
+	// Output: [1 3 0 1 2 103 111]
+	// Some[int64](-2) None[string]() Some[string](go)
+}
+
+func TestWireIdentity(t *testing.T) {
+	err := quick.Check(func(
+		b opt.T[bool], i opt.T[int], i8 opt.T[int8], u64 opt.T[uint64],
+		f32 opt.T[float32], c128 opt.T[complex128], s opt.T[string], thing opt.T[Thing],
+	) bool {
+		var data []byte
+
+		data, _ = b.AppendWire(data)
+		data, _ = i.AppendWire(data)
+		data, _ = i8.AppendWire(data)
+		data, _ = u64.AppendWire(data)
+		data, _ = f32.AppendWire(data)
+		data, _ = c128.AppendWire(data)
+		data, _ = s.AppendWire(data)
+
+		data, err := thing.AppendWire(data)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		return decodeWireEqual(t, &data, b) && decodeWireEqual(t, &data, i) &&
+			decodeWireEqual(t, &data, i8) && decodeWireEqual(t, &data, u64) &&
+			decodeWireEqual(t, &data, f32) && decodeWireEqual(t, &data, c128) &&
+			decodeWireEqual(t, &data, s) && decodeWireEqual(t, &data, thing) && len(data) == 0
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func decodeWireEqual[V comparable](t *testing.T, data *[]byte, want opt.T[V]) bool {
+	got, n, err := opt.DecodeWire[V](*data)
+	if err != nil {
+		t.Log(err.Error())
+		return false
+	}
+
+	*data = (*data)[n:]
+
+	if got != want {
+		t.Logf("decoded %v, want %v", got, want)
+		return false
+	}
+
+	return true
+}
+
+func TestWireBinaryMarshaler(t *testing.T) {
+	want := opt.Some(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	data, err := want.AppendWire([]byte("prefix"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, n, err := opt.DecodeWire[time.Time](data[len("prefix"):])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !got.Must().Equal(want.Must()) || n != len(data)-len("prefix") {
+		t.Errorf("decoded %v (%d bytes), want %v (%d bytes)", got, n, want, len(data)-len("prefix"))
+	}
+}
+
+func TestWirePointerMarshaler(t *testing.T) {
+	want := opt.Some(Version{major: 1, minor: 2})
+
+	data, err := want.AppendWire(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(data, []byte{1, 3, 'v', 1, 2}) {
+		t.Errorf("appended %v, want %v", data, []byte{1, 3, 'v', 1, 2})
+	}
+
+	got, n, err := opt.DecodeWire[Version](data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got != want || n != len(data) {
+		t.Errorf("decoded %v (%d bytes), want %v (%d bytes)", got, n, want, len(data))
+	}
+}
+
+func TestWireNaN(t *testing.T) {
+	data, _ := opt.Some(math.NaN()).AppendWire(nil)
+
+	got, _, err := opt.DecodeWire[float64](data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !math.IsNaN(got.Must()) {
+		t.Errorf("expected NaN, got %v", got)
+	}
+}
+
+func TestDecodeWireInvalid(t *testing.T) {
+	for _, input := range [][]byte{{}, {2}, {1}, {1, 0x80}, {1, 0xfe, 0x03}} {
+		_, _, err := opt.DecodeWire[int8](input)
+		if !errors.Is(err, opt.ErrInvalidBinary) {
+			t.Errorf("decode %v: expected %v, got %v", input, opt.ErrInvalidBinary, err)
+		}
+	}
+
+	for _, input := range [][]byte{{1}, {1, 2}, {1, 3, 'a'}} {
+		_, _, err := opt.DecodeWire[string](input)
+		if !errors.Is(err, opt.ErrInvalidBinary) {
+			t.Errorf("decode %v: expected %v, got %v", input, opt.ErrInvalidBinary, err)
+		}
+	}
+}
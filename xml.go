@@ -0,0 +1,109 @@
+package opt
+
+import "encoding/xml"
+
+// XML Marshalling und Unmarshalling.
+var (
+	_ xml.Marshaler       = T[any]{}
+	_ xml.Unmarshaler     = &T[any]{}
+	_ xml.MarshalerAttr   = T[any]{}
+	_ xml.UnmarshalerAttr = &T[any]{}
+)
+
+// xsiNamespace is the namespace of the xsi:nil attribute.
+const xsiNamespace = "http://www.w3.org/2001/XMLSchema-instance"
+
+// MarshalXML implements [xml.Marshaler].
+//
+// Empty options are omitted, see [XMLNillable] to encode them as xsi:nil instead.
+func (t T[V]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	value, present := t.Unwrap()
+	if !present {
+		return nil
+	}
+
+	return e.EncodeElement(value, start)
+}
+
+// UnmarshalXML implements [xml.Unmarshaler].
+//
+// Elements with the attribute xsi:nil="true" are decoded as empty options,
+// while absent elements leave the option untouched.
+func (t *T[V]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	if isXSINil(start) {
+		*t = None[V]()
+
+		return d.Skip()
+	}
+
+	var value V
+
+	err := d.DecodeElement(&value, &start)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
+
+// MarshalXMLAttr implements [xml.MarshalerAttr].
+//
+// Empty options are omitted, present values are encoded as text, see [T.MarshalText].
+func (t T[V]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return xml.Attr{}, nil
+	}
+
+	text, err := marshalText(value)
+	if err != nil {
+		return xml.Attr{}, err
+	}
+
+	return xml.Attr{Name: name, Value: string(text)}, nil
+}
+
+// UnmarshalXMLAttr implements [xml.UnmarshalerAttr].
+//
+// In contrast to [T.UnmarshalText], an empty attribute is decoded as a present value,
+// since absent attributes are simply not decoded.
+func (t *T[V]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return t.unmarshalPresentText([]byte(attr.Value))
+}
+
+func isXSINil(start xml.StartElement) bool {
+	for _, attr := range start.Attr {
+		if attr.Name.Local == "nil" && (attr.Name.Space == xsiNamespace || attr.Name.Space == "xsi") {
+			return attr.Value == "true" || attr.Value == "1"
+		}
+	}
+
+	return false
+}
+
+// XMLNillable is an option, that encodes empty options as elements
+// with the attribute xsi:nil="true", instead of omitting them.
+type XMLNillable[V any] struct {
+	T[V]
+}
+
+// MarshalXML implements [xml.Marshaler].
+func (n XMLNillable[V]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if n.present {
+		return n.T.MarshalXML(e, start)
+	}
+
+	start.Attr = append(start.Attr,
+		xml.Attr{Name: xml.Name{Local: "xmlns:xsi"}, Value: xsiNamespace},
+		xml.Attr{Name: xml.Name{Local: "xsi:nil"}, Value: "true"},
+	)
+
+	err := e.EncodeToken(start)
+	if err != nil {
+		return err
+	}
+
+	return e.EncodeToken(start.End())
+}
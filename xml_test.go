@@ -0,0 +1,97 @@
+package opt_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"testing"
+	"testing/quick"
+
+	"github.com/lukasngl/opt"
+)
+
+type Envelope struct {
+	XMLName  xml.Name                `xml:"envelope"`
+	ID       opt.T[int]              `xml:"id,attr"`
+	Name     opt.String              `xml:"name"`
+	Nickname opt.String              `xml:"nickname"`
+	Email    opt.XMLNillable[string] `xml:"email"`
+}
+
+func ExampleT_MarshalXML() {
+	data, _ := xml.Marshal(Envelope{
+		ID:       opt.Some(42),
+		Name:     opt.Some("gopher"),
+		Nickname: opt.None[string](),
+		Email:    opt.XMLNillable[string]{},
+	})
+
+	fmt.Println(string(data))
+	// Output: <envelope id="42"><name>gopher</name><email xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:nil="true"></email></envelope>
+}
+
+func ExampleT_UnmarshalXML() {
+	var envelope Envelope
+
+	_ = xml.Unmarshal([]byte(`<envelope xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">`+
+		`<name>gopher</name><email xsi:nil="true"/></envelope>`), &envelope)
+
+	fmt.Println(envelope.ID, envelope.Name, envelope.Nickname, envelope.Email)
+	// Output: None[int]() Some[string](gopher) None[string]() None[string]()
+}
+
+func TestXMLEmptyElement(t *testing.T) {
+	var envelope Envelope
+
+	err := xml.Unmarshal([]byte(`<envelope id=""><name></name></envelope>`), &envelope)
+	if err == nil {
+		t.Fatal("expected error decoding an empty attribute into an int")
+	}
+
+	err = xml.Unmarshal([]byte(`<envelope><name></name></envelope>`), &envelope)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !opt.Contains(envelope.Name, "") {
+		t.Fatalf("expected empty element to be present, got %s", envelope.Name)
+	}
+}
+
+func TestXMLIdentity(t *testing.T) {
+	type Thing struct {
+		Attr   opt.T[int64]         `xml:"attr,attr"`
+		Bool   opt.Bool             `xml:"bool"`
+		String opt.String           `xml:"string"`
+		Uint16 opt.Uint16           `xml:"uint16"`
+		Nil    opt.XMLNillable[int] `xml:"nil"`
+	}
+
+	err := quick.Check(func(attr opt.T[int64], b opt.T[bool], s opt.T[uint16], n opt.T[int]) bool {
+		var de Thing
+
+		ser := Thing{Attr: attr, Bool: b, String: opt.Some("<&>"), Uint16: s, Nil: opt.XMLNillable[int]{T: n}}
+
+		data, err := xml.Marshal(ser)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		err = xml.Unmarshal(data, &de)
+		if err != nil {
+			t.Log(err.Error())
+			return false
+		}
+
+		if de != ser {
+			t.Logf("ser: %#v", ser)
+			t.Logf("de: %#v", de)
+			t.Log(string(data))
+		}
+
+		return de == ser
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,43 @@
+package opt
+
+// YAML Marshalling und Unmarshalling.
+//
+// The interfaces are declared locally,
+// such that the package does not depend on any YAML library.
+var (
+	_ interface{ MarshalYAML() (any, error) }           = T[any]{}
+	_ interface{ UnmarshalYAML(func(any) error) error } = &T[any]{}
+)
+
+// MarshalYAML implements the Marshaler interface of gopkg.in/yaml.v3 and gopkg.in/yaml.v2,
+// which is the InterfaceMarshaler interface of github.com/goccy/go-yaml.
+//
+// Empty options are encoded as null,
+// use the omitempty struct tag, which honors [T.IsZero], to omit them instead.
+func (t T[V]) MarshalYAML() (any, error) {
+	value, present := t.Unwrap()
+	if !present {
+		return nil, nil
+	}
+
+	return value, nil
+}
+
+// UnmarshalYAML implements the obsolete Unmarshaler interface of gopkg.in/yaml.v3,
+// which is the Unmarshaler interface of gopkg.in/yaml.v2,
+// and the InterfaceUnmarshaler interface of github.com/goccy/go-yaml.
+//
+// Null values are not passed to unmarshalers, thus absent and null keys
+// leave the option untouched, which is empty for freshly declared values.
+func (t *T[V]) UnmarshalYAML(unmarshal func(any) error) error {
+	var value V
+
+	err := unmarshal(&value)
+	if err != nil {
+		return err
+	}
+
+	*t = Some(value)
+
+	return nil
+}
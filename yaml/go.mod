@@ -0,0 +1,11 @@
+module github.com/lukasngl/opt/yaml
+
+go 1.22
+
+replace github.com/lukasngl/opt => ../
+
+require (
+	github.com/goccy/go-yaml v1.19.2
+	github.com/lukasngl/opt v0.0.0
+	gopkg.in/yaml.v3 v3.0.1
+)
@@ -0,0 +1,131 @@
+package yaml_test
+
+import (
+	"strings"
+	"testing"
+	"testing/quick"
+
+	goccy "github.com/goccy/go-yaml"
+	"gopkg.in/yaml.v3"
+
+	"github.com/lukasngl/opt"
+)
+
+type library struct {
+	name      string
+	marshal   func(any) ([]byte, error)
+	unmarshal func([]byte, any) error
+}
+
+var libraries = []library{
+	{"yaml.v3", yaml.Marshal, yaml.Unmarshal},
+	{"goccy", goccy.Marshal, func(data []byte, v any) error { return goccy.Unmarshal(data, v) }},
+}
+
+func forEachLibrary(t *testing.T, test func(*testing.T, library)) {
+	t.Helper()
+
+	for _, lib := range libraries {
+		t.Run(lib.name, func(t *testing.T) { test(t, lib) })
+	}
+}
+
+type Config struct {
+	Name    opt.String      `yaml:"name"`
+	Port    opt.T[int]      `yaml:"port"`
+	Debug   opt.Bool        `yaml:"debug,omitempty"`
+	Ratio   opt.Float64     `yaml:"ratio"`
+	Tags    opt.T[[]string] `yaml:"tags,omitempty"`
+	Nothing opt.String      `yaml:"nothing"`
+}
+
+func TestMarshal(t *testing.T) {
+	forEachLibrary(t, func(t *testing.T, lib library) {
+		data, err := lib.marshal(Config{
+			Name:  opt.Some("gopher"),
+			Port:  opt.Some(8080),
+			Debug: opt.None[bool](),
+			Ratio: opt.Some(0.5),
+			Tags:  opt.Some([]string{"a", "b"}),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, want := range []string{"name: gopher\n", "port: 8080\n", "ratio: 0.5\n", "- b\n", "nothing: null\n"} {
+			if !strings.Contains(string(data), want) {
+				t.Errorf("expected %q in:\n%s", want, data)
+			}
+		}
+
+		if strings.Contains(string(data), "debug") {
+			t.Errorf("expected empty option with omitempty to be omitted:\n%s", data)
+		}
+	})
+}
+
+func TestUnmarshal(t *testing.T) {
+	forEachLibrary(t, func(t *testing.T, lib library) {
+		var config Config
+
+		err := lib.unmarshal([]byte("name: gopher\nport: 8080\nratio: null\ntags: [a]\n"), &config)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !opt.Contains(config.Name, "gopher") || !opt.Contains(config.Port, 8080) {
+			t.Errorf("expected name and port to be present, got %s and %s", config.Name, config.Port)
+		}
+
+		if config.Ratio.IsPresent() || config.Debug.IsPresent() || config.Nothing.IsPresent() {
+			t.Errorf("expected null and absent keys to be empty, got %+v", config)
+		}
+
+		if config.Tags.String() != "Some[[]string]([a])" {
+			t.Errorf("expected tags to be present, got %s", config.Tags)
+		}
+	})
+}
+
+type Thing struct {
+	Bool    opt.Bool     `yaml:"bool"`
+	Float64 opt.Float64  `yaml:"float64"`
+	Int64   opt.T[int64] `yaml:"int64"`
+	String  opt.String   `yaml:"string"`
+	Uint16  opt.Uint16   `yaml:"uint16"`
+	Struct  opt.T[struct {
+		Test  string
+		Test2 int
+	}] `yaml:"struct"`
+}
+
+func TestMarshalIdentity(t *testing.T) {
+	forEachLibrary(t, func(t *testing.T, lib library) {
+		err := quick.Check(func(ser Thing) bool {
+			var de Thing
+
+			data, err := lib.marshal(ser)
+			if err != nil {
+				t.Log(err.Error())
+				return false
+			}
+
+			err = lib.unmarshal(data, &de)
+			if err != nil {
+				t.Log(err.Error())
+				return false
+			}
+
+			if de != ser {
+				t.Logf("ser: %#v", ser)
+				t.Logf("de: %#v", de)
+				t.Log(string(data))
+			}
+
+			return de == ser
+		}, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+}